@@ -0,0 +1,323 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/mikelorant/committed/internal/commit"
+	"github.com/mikelorant/committed/internal/config"
+	"github.com/mikelorant/committed/internal/theme"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/rodaine/table"
+	"github.com/spf13/cobra"
+)
+
+const (
+	configInitSuccess  = "✅ Config written: %v\n"
+	configSetSuccess   = "✅ Config updated: %v\n"
+	configValidSuccess = "✅ Config valid."
+	configMigrated     = "✅ Config migrated from version %v: %v\n"
+	configCurrent      = "✅ Config is current: %v\n"
+)
+
+func NewConfigCmd(a App) *cobra.Command {
+	var opts commit.Options
+
+	cmd := &cobra.Command{
+		Use:   "config",
+		Short: "Show and manage the configuration",
+		Long: "Show the effective value of every setting and the layer that supplied it.\n" +
+			"Layers are applied in order: defaults, global, git, project, repository,\n" +
+			"profile, environment and flags.",
+		Args: cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			cfg, srcs, err := a.Configer.LoadConfig(opts)
+			if err != nil {
+				a.Logger.Fatalf("Unable to load config: %v", err)
+
+				return
+			}
+
+			vs, err := config.Values(cfg, srcs)
+			if err != nil {
+				a.Logger.Fatalf("Unable to get config values: %v", err)
+
+				return
+			}
+
+			configValues(a.Writer, vs)
+		},
+	}
+
+	cmd.AddCommand(newConfigInitCmd(a, &opts))
+	cmd.AddCommand(newConfigGetCmd(a, &opts))
+	cmd.AddCommand(newConfigSetCmd(a, &opts))
+	cmd.AddCommand(newConfigValidateCmd(a, &opts))
+	cmd.AddCommand(newConfigPathCmd(a, &opts))
+	cmd.AddCommand(newConfigEditCmd(a, &opts))
+	cmd.AddCommand(newConfigMigrateCmd(a, &opts))
+	cmd.AddCommand(newConfigSchemaCmd(a))
+
+	cmd.Flags().SortFlags = false
+	cmd.PersistentFlags().SortFlags = false
+	cmd.PersistentFlags().StringVarP(&opts.ConfigFile, "config", "", defaultConfigFile, "Config file location")
+	cmd.Flags().StringArrayVarP(&opts.Overrides, "option", "", nil, "Override a setting (key=value)")
+	cmd.Flags().BoolVarP(&opts.Strict, "strict", "", false, "Reject unknown config keys")
+	cmd.Flags().StringVarP(&opts.Profile, "profile", "", "", "Config profile to apply")
+
+	return cmd
+}
+
+func newConfigInitCmd(a App, opts *commit.Options) *cobra.Command {
+	var repo, force bool
+
+	cmd := &cobra.Command{
+		Use:   "init",
+		Short: "Write a config file with every setting documented",
+		Args:  cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			file, err := a.Configer.ConfigFile(*opts, repo)
+			if err != nil {
+				a.Logger.Fatalf("Unable to locate config file: %v", err)
+
+				return
+			}
+
+			if err := a.Configer.InitConfig(file, force); err != nil {
+				a.Logger.Fatalf("Unable to initialise config: %v", err)
+
+				return
+			}
+
+			fmt.Fprintf(a.Writer, configInitSuccess, file)
+		},
+	}
+
+	cmd.Flags().SortFlags = false
+	cmd.Flags().BoolVar(&repo, "repository", false, "Use the repository config file")
+	cmd.Flags().BoolVar(&force, "force", false, "Replace an existing config file")
+
+	return cmd
+}
+
+func newConfigGetCmd(a App, opts *commit.Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "get <key>",
+		Short:   "Print the effective value of a setting",
+		Example: "  committed config get view.theme\n  committed config get commit",
+		Args:    cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			vs, err := a.Configer.GetConfig(*opts, args[0])
+			if err != nil {
+				a.Logger.Fatalf("Unable to get config value: %v", err)
+
+				return
+			}
+
+			if len(vs) == 1 && vs[0].Key == args[0] {
+				fmt.Fprintln(a.Writer, vs[0].Value)
+
+				return
+			}
+
+			configValues(a.Writer, vs)
+		},
+	}
+
+	cmd.Flags().SortFlags = false
+	cmd.Flags().StringArrayVarP(&opts.Overrides, "option", "", nil, "Override a setting (key=value)")
+	cmd.Flags().StringVarP(&opts.Profile, "profile", "", "", "Config profile to apply")
+
+	return cmd
+}
+
+func newConfigSetCmd(a App, opts *commit.Options) *cobra.Command {
+	var repo bool
+
+	cmd := &cobra.Command{
+		Use:     "set <key> <value>",
+		Short:   "Change a setting in a config file",
+		Example: "  committed config set view.theme nord\n  committed config set commit.scopes \"[ui, cmd]\"",
+		Args:    cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			file, err := a.Configer.ConfigFile(*opts, repo)
+			if err != nil {
+				a.Logger.Fatalf("Unable to locate config file: %v", err)
+
+				return
+			}
+
+			if err := a.Configer.SetConfig(file, args[0], args[1]); err != nil {
+				a.Logger.Fatalf("Unable to set config value: %v", err)
+
+				return
+			}
+
+			fmt.Fprintf(a.Writer, configSetSuccess, file)
+		},
+	}
+
+	cmd.Flags().SortFlags = false
+	cmd.Flags().BoolVar(&repo, "repository", false, "Use the repository config file")
+
+	return cmd
+}
+
+func newConfigValidateCmd(a App, opts *commit.Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validate",
+		Short: "Check config files for invalid values and unknown keys",
+		Args:  cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			ps, err := a.Configer.ValidateConfig(*opts)
+			if err != nil {
+				a.Logger.Fatalf("Unable to validate config: %v", err)
+
+				return
+			}
+
+			if len(ps) == 0 {
+				fmt.Fprintln(a.Writer, configValidSuccess)
+
+				return
+			}
+
+			for _, p := range ps {
+				fmt.Fprintln(a.Writer, p)
+			}
+
+			a.Logger.Fatalf("Config has %d problem(s).", len(ps))
+		},
+	}
+
+	cmd.Flags().BoolVarP(&opts.Strict, "strict", "", false, "Report unknown config keys")
+
+	return cmd
+}
+
+func newConfigPathCmd(a App, opts *commit.Options) *cobra.Command {
+	var repo bool
+
+	cmd := &cobra.Command{
+		Use:   "path",
+		Short: "Print the location of the config file",
+		Args:  cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			file, err := a.Configer.ConfigFile(*opts, repo)
+			if err != nil {
+				a.Logger.Fatalf("Unable to locate config file: %v", err)
+
+				return
+			}
+
+			fmt.Fprintln(a.Writer, file)
+		},
+	}
+
+	cmd.Flags().BoolVar(&repo, "repository", false, "Use the repository config file")
+
+	return cmd
+}
+
+func newConfigEditCmd(a App, opts *commit.Options) *cobra.Command {
+	var repo bool
+
+	cmd := &cobra.Command{
+		Use:   "edit",
+		Short: "Open the config file in $VISUAL or $EDITOR",
+		Args:  cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			file, err := a.Configer.ConfigFile(*opts, repo)
+			if err != nil {
+				a.Logger.Fatalf("Unable to locate config file: %v", err)
+
+				return
+			}
+
+			if err := a.Configer.EditConfig(file); err != nil {
+				a.Logger.Fatalf("Unable to edit config: %v", err)
+			}
+		},
+	}
+
+	cmd.Flags().BoolVar(&repo, "repository", false, "Use the repository config file")
+
+	return cmd
+}
+
+func newConfigMigrateCmd(a App, opts *commit.Options) *cobra.Command {
+	var repo bool
+
+	cmd := &cobra.Command{
+		Use:   "migrate",
+		Short: "Upgrade a config file to the current version",
+		Long: "Upgrade a config file to the current version in place. Comments and the\n" +
+			"order of settings are kept.",
+		Args: cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			file, err := a.Configer.ConfigFile(*opts, repo)
+			if err != nil {
+				a.Logger.Fatalf("Unable to locate config file: %v", err)
+
+				return
+			}
+
+			from, err := a.Configer.MigrateConfig(file)
+			if err != nil {
+				a.Logger.Fatalf("Unable to migrate config: %v", err)
+
+				return
+			}
+
+			if from == config.Version {
+				fmt.Fprintf(a.Writer, configCurrent, file)
+
+				return
+			}
+
+			fmt.Fprintf(a.Writer, configMigrated, from, file)
+		},
+	}
+
+	cmd.Flags().BoolVar(&repo, "repository", false, "Use the repository config file")
+
+	return cmd
+}
+
+func newConfigSchemaCmd(a App) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schema",
+		Short: "Print a JSON Schema for config files",
+		Long: "Print a JSON Schema describing config files. Editors use the schema to\n" +
+			"complete and validate settings.",
+		Args: cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			out, err := a.Configer.ConfigSchema()
+			if err != nil {
+				a.Logger.Fatalf("Unable to generate schema: %v", err)
+
+				return
+			}
+
+			a.Writer.Write(out)
+		},
+	}
+
+	return cmd
+}
+
+func configValues(w io.Writer, vs []config.Value) {
+	th := theme.New(config.ColourAdaptive)
+
+	tbl := table.New("Key", "Value", "Source")
+	tbl.WithHeaderFormatter(header(th.Registry))
+	tbl.WithWidthFunc(lipgloss.Width)
+	tbl.WithWriter(w)
+
+	for _, v := range vs {
+		tbl.AddRow(v.Key, v.Value, v.Source)
+	}
+
+	tbl.Print()
+}
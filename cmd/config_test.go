@@ -0,0 +1,416 @@
+package cmd_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/mikelorant/committed/cmd"
+	"github.com/mikelorant/committed/internal/commit"
+	"github.com/mikelorant/committed/internal/config"
+
+	"github.com/hexops/autogold/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+type MockConfig struct {
+	opts     commit.Options
+	cfg      config.Config
+	sources  config.Sources
+	values   []config.Value
+	problems []config.Problem
+	file     string
+	repo     bool
+	force    bool
+	set      []string
+	version  int
+	schema   []byte
+	err      error
+}
+
+func (c *MockConfig) LoadConfig(opts commit.Options) (config.Config, config.Sources, error) {
+	c.opts = opts
+
+	return c.cfg, c.sources, c.err
+}
+
+func (c *MockConfig) ConfigFile(opts commit.Options, repo bool) (string, error) {
+	c.opts = opts
+	c.repo = repo
+
+	if repo {
+		return "/repo/.committed.yaml", c.err
+	}
+
+	return opts.ConfigFile, c.err
+}
+
+func (c *MockConfig) InitConfig(file string, force bool) error {
+	c.file = file
+	c.force = force
+
+	return c.err
+}
+
+func (c *MockConfig) GetConfig(opts commit.Options, key string) ([]config.Value, error) {
+	c.opts = opts
+
+	return c.values, c.err
+}
+
+func (c *MockConfig) SetConfig(file, key, value string) error {
+	c.file = file
+	c.set = []string{key, value}
+
+	return c.err
+}
+
+func (c *MockConfig) ValidateConfig(opts commit.Options) ([]config.Problem, error) {
+	c.opts = opts
+
+	return c.problems, c.err
+}
+
+func (c *MockConfig) EditConfig(file string) error {
+	c.file = file
+
+	return c.err
+}
+
+func (c *MockConfig) MigrateConfig(file string) (int, error) {
+	c.file = file
+
+	return c.version, c.err
+}
+
+func (c *MockConfig) ConfigSchema() ([]byte, error) {
+	return c.schema, c.err
+}
+
+func TestConfigCmd(t *testing.T) {
+	type args struct {
+		args    []string
+		cfg     config.Config
+		sources config.Sources
+		err     error
+	}
+
+	type want struct {
+		opts commit.Options
+	}
+
+	tests := []struct {
+		name string
+		args args
+		want want
+	}{
+		{
+			name: "config_default",
+			args: args{
+				args: []string{"--"},
+			},
+			want: want{
+				opts: commit.Options{
+					ConfigFile: "$HOME/.config/committed/config.yaml",
+				},
+			},
+		},
+		{
+			name: "config_layers",
+			args: args{
+				args: []string{"--config", "config.yaml", "--option", "commit.signoff=true", "--strict", "--profile", "work"},
+				cfg: config.Config{
+					View:   config.View{Theme: "nord", EmojiSet: config.EmojiSetDevmoji, Focus: config.FocusSummary},
+					Commit: config.Commit{Signoff: true},
+				},
+				sources: config.Sources{
+					"view.theme":     {Layer: config.LayerGlobal, File: "config.yaml"},
+					"view.emojiSet":  {Layer: config.LayerRepository, File: "/repo/.committed.yaml"},
+					"commit.signoff": {Layer: config.LayerFlag},
+					"view.focus":     {Layer: config.LayerProfile, Profile: "work"},
+				},
+			},
+			want: want{
+				opts: commit.Options{
+					ConfigFile: "config.yaml",
+					Overrides:  []string{"commit.signoff=true"},
+					Strict:     true,
+					Profile:    "work",
+				},
+			},
+		},
+		{
+			name: "config_error",
+			args: args{
+				args: []string{"--"},
+				err:  errMock,
+			},
+			want: want{
+				opts: commit.Options{
+					ConfigFile: "$HOME/.config/committed/config.yaml",
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+
+			mlog := NewMockLogger(&buf)
+
+			c := MockConfig{
+				cfg:     tt.args.cfg,
+				sources: tt.args.sources,
+				err:     tt.args.err,
+			}
+
+			a := cmd.App{
+				Configer: &c,
+				Logger:   mlog,
+				Writer:   &buf,
+			}
+
+			ccmd := cmd.NewConfigCmd(a)
+
+			ccmd.SetOut(&buf)
+			ccmd.SetErr(&buf)
+			ccmd.SetArgs(tt.args.args)
+
+			ccmd.Execute()
+
+			assert.Equal(t, tt.want.opts, c.opts)
+
+			output := stripString(buf.String())
+			autogold.ExpectFile(t, autogold.Raw(output), autogold.Name(tt.name))
+		})
+	}
+}
+
+func TestConfigSubcommands(t *testing.T) {
+	type args struct {
+		args     []string
+		values   []config.Value
+		problems []config.Problem
+		version  int
+		schema   string
+		err      error
+	}
+
+	type want struct {
+		file   string
+		repo   bool
+		force  bool
+		strict bool
+		set    []string
+	}
+
+	tests := []struct {
+		name string
+		args args
+		want want
+	}{
+		{
+			name: "config_init",
+			args: args{
+				args: []string{"init", "--config", "config.yaml"},
+			},
+			want: want{
+				file: "config.yaml",
+			},
+		},
+		{
+			name: "config_init_repository",
+			args: args{
+				args: []string{"init", "--repository", "--force"},
+			},
+			want: want{
+				file:  "/repo/.committed.yaml",
+				repo:  true,
+				force: true,
+			},
+		},
+		{
+			name: "config_init_error",
+			args: args{
+				args: []string{"init"},
+				err:  errMock,
+			},
+		},
+		{
+			name: "config_get",
+			args: args{
+				args: []string{"get", "view.theme"},
+				values: []config.Value{
+					{Key: "view.theme", Value: "nord", Source: config.Source{Layer: config.LayerGlobal}},
+				},
+			},
+		},
+		{
+			name: "config_get_group",
+			args: args{
+				args: []string{"get", "commit"},
+				values: []config.Value{
+					{Key: "commit.signoff", Value: "true", Source: config.Source{Layer: config.LayerRepository}},
+					{Key: "commit.style", Value: "emoji", Source: config.Source{Layer: config.LayerDefault}},
+				},
+			},
+		},
+		{
+			name: "config_get_error",
+			args: args{
+				args: []string{"get", "view.unknown"},
+				err:  errMock,
+			},
+		},
+		{
+			name: "config_set",
+			args: args{
+				args: []string{"set", "view.theme", "nord", "--config", "config.yaml"},
+			},
+			want: want{
+				file: "config.yaml",
+				set:  []string{"view.theme", "nord"},
+			},
+		},
+		{
+			name: "config_set_repository",
+			args: args{
+				args: []string{"set", "commit.signoff", "true", "--repository"},
+			},
+			want: want{
+				file: "/repo/.committed.yaml",
+				repo: true,
+				set:  []string{"commit.signoff", "true"},
+			},
+		},
+		{
+			name: "config_validate",
+			args: args{
+				args: []string{"validate"},
+			},
+		},
+		{
+			name: "config_validate_problems",
+			args: args{
+				args: []string{"validate"},
+				problems: []config.Problem{
+					{File: "config.yaml", Line: 2, Column: 3, Key: "view.unknown", Message: "unknown key"},
+					{File: "config.yaml", Line: 3, Column: 13, Key: "view.emojiSet", Message: `invalid value, allowed values: gitmoji, devmoji, emojilog: "invalid"`},
+				},
+			},
+		},
+		{
+			name: "config_validate_strict",
+			args: args{
+				args: []string{"validate", "--strict"},
+			},
+			want: want{
+				strict: true,
+			},
+		},
+		{
+			name: "config_path",
+			args: args{
+				args: []string{"path"},
+			},
+		},
+		{
+			name: "config_path_repository",
+			args: args{
+				args: []string{"path", "--repository"},
+			},
+			want: want{
+				repo: true,
+			},
+		},
+		{
+			name: "config_edit",
+			args: args{
+				args: []string{"edit", "--config", "config.yaml"},
+			},
+			want: want{
+				file: "config.yaml",
+			},
+		},
+		{
+			name: "config_migrate",
+			args: args{
+				args: []string{"migrate", "--config", "config.yaml"},
+			},
+			want: want{
+				file: "config.yaml",
+			},
+		},
+		{
+			name: "config_migrate_current",
+			args: args{
+				args:    []string{"migrate", "--repository"},
+				version: config.Version,
+			},
+			want: want{
+				file: "/repo/.committed.yaml",
+				repo: true,
+			},
+		},
+		{
+			name: "config_migrate_error",
+			args: args{
+				args: []string{"migrate", "--config", "config.yaml"},
+				err:  errMock,
+			},
+		},
+		{
+			name: "config_schema",
+			args: args{
+				args:   []string{"schema"},
+				schema: "{\n  \"type\": \"object\"\n}\n",
+			},
+		},
+		{
+			name: "config_schema_error",
+			args: args{
+				args: []string{"schema"},
+				err:  errMock,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+
+			mlog := NewMockLogger(&buf)
+
+			c := MockConfig{
+				values:   tt.args.values,
+				problems: tt.args.problems,
+				version:  tt.args.version,
+				schema:   []byte(tt.args.schema),
+				err:      tt.args.err,
+			}
+
+			a := cmd.App{
+				Configer: &c,
+				Logger:   mlog,
+				Writer:   &buf,
+			}
+
+			ccmd := cmd.NewConfigCmd(a)
+
+			ccmd.SetOut(&buf)
+			ccmd.SetErr(&buf)
+			ccmd.SetArgs(tt.args.args)
+
+			ccmd.Execute()
+
+			assert.Equal(t, tt.want.file, c.file)
+			assert.Equal(t, tt.want.repo, c.repo)
+			assert.Equal(t, tt.want.force, c.force)
+			assert.Equal(t, tt.want.strict, c.opts.Strict)
+			assert.Equal(t, tt.want.set, c.set)
+
+			output := stripString(buf.String())
+			autogold.ExpectFile(t, autogold.Raw(output), autogold.Name(tt.name))
+		})
+	}
+}
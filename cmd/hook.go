@@ -2,9 +2,14 @@ package cmd
 
 import (
 	"fmt"
+	"io"
 
+	"github.com/mikelorant/committed/internal/config"
 	"github.com/mikelorant/committed/internal/hook"
+	"github.com/mikelorant/committed/internal/theme"
 
+	"github.com/charmbracelet/lipgloss"
+	"github.com/rodaine/table"
 	"github.com/spf13/cobra"
 )
 
@@ -14,7 +19,10 @@ const (
 )
 
 func NewHookCmd(a App) *cobra.Command {
-	var hookOptions hook.Options
+	var (
+		hookOptions hook.Options
+		scope       string
+	)
 
 	cmd := &cobra.Command{
 		Use:   "hook",
@@ -25,6 +33,27 @@ func NewHookCmd(a App) *cobra.Command {
 				return
 			}
 
+			s, err := hook.ParseScope(scope)
+			if err != nil {
+				a.Logger.Fatalf("Invalid hook scope: %v", scope)
+
+				return
+			}
+			hookOptions.Scope = s
+
+			if hookOptions.Status {
+				ss, err := a.Hooker.Status(hookOptions)
+				if err != nil {
+					a.Logger.Fatalf("Unable to get hook status.")
+
+					return
+				}
+
+				hookStatus(a.Writer, ss)
+
+				return
+			}
+
 			if err := a.Hooker.Do(hookOptions); err != nil {
 				a.Logger.Fatalf("Unable to install or uninstall hook.")
 
@@ -43,14 +72,21 @@ func NewHookCmd(a App) *cobra.Command {
 	cmd.Flags().SortFlags = false
 	cmd.Flags().BoolVar(&hookOptions.Install, "install", false, "Install Git hook")
 	cmd.Flags().BoolVar(&hookOptions.Uninstall, "uninstall", false, "Uninstall Git hook")
+	cmd.Flags().BoolVar(&hookOptions.Status, "status", false, "Show status of Git hooks")
+	cmd.Flags().BoolVar(&hookOptions.Validate, "validate", false, "Install commit-msg hook to validate messages")
+	cmd.Flags().BoolVar(&hookOptions.Chain, "chain", false, "Keep existing Git hook and run it first")
+	cmd.Flags().StringVar(&scope, "scope", "", "Hook location (global, local)")
 	cmd.Flags().Lookup("install").NoOptDefVal = "true"
 	cmd.Flags().Lookup("uninstall").NoOptDefVal = "true"
+	cmd.Flags().Lookup("status").NoOptDefVal = "true"
+	cmd.Flags().Lookup("validate").NoOptDefVal = "true"
+	cmd.Flags().Lookup("chain").NoOptDefVal = "true"
 
 	return cmd
 }
 
 func help(cmd *cobra.Command, opts hook.Options) bool {
-	if !(opts.Install || opts.Uninstall) {
+	if !(opts.Install || opts.Uninstall || opts.Status) {
 		cmd.Help()
 
 		return true
@@ -58,3 +94,27 @@ func help(cmd *cobra.Command, opts hook.Options) bool {
 
 	return false
 }
+
+func hookStatus(w io.Writer, ss []hook.Status) {
+	th := theme.New(config.ColourAdaptive)
+
+	tbl := table.New("Scope", "Location", "Active", "Exists", "Managed", "Executable", "Outdated", "Chained")
+	tbl.WithHeaderFormatter(header(th.Registry))
+	tbl.WithWidthFunc(lipgloss.Width)
+	tbl.WithWriter(w)
+
+	for _, s := range ss {
+		tbl.AddRow(s.Scope, s.Location, yesNo(s.Active), yesNo(s.Exists), yesNo(s.Managed),
+			yesNo(s.Executable), yesNo(s.Outdated), yesNo(s.Chained))
+	}
+
+	tbl.Print()
+}
+
+func yesNo(b bool) string {
+	if b {
+		return "yes"
+	}
+
+	return "no"
+}
@@ -15,8 +15,9 @@ import (
 )
 
 type MockHook struct {
-	opts hook.Options
-	err  error
+	opts     hook.Options
+	statuses []hook.Status
+	err      error
 }
 
 func (h *MockHook) Do(opts hook.Options) error {
@@ -29,10 +30,17 @@ func (h *MockHook) Do(opts hook.Options) error {
 	return nil
 }
 
+func (h *MockHook) Status(opts hook.Options) ([]hook.Status, error) {
+	h.opts = opts
+
+	return h.statuses, h.err
+}
+
 func TestHookCmd(t *testing.T) {
 	type args struct {
-		args []string
-		err  error
+		args     []string
+		statuses []hook.Status
+		err      error
 	}
 
 	type want struct {
@@ -79,6 +87,95 @@ func TestHookCmd(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "install_validate",
+			args: args{
+				args: []string{"--install", "--validate"},
+			},
+			want: want{
+				opts: hook.Options{
+					Install:  true,
+					Validate: true,
+				},
+			},
+		},
+		{
+			name: "install_chain",
+			args: args{
+				args: []string{"--install", "--chain"},
+			},
+			want: want{
+				opts: hook.Options{
+					Install: true,
+					Chain:   true,
+				},
+			},
+		},
+		{
+			name: "install_scope",
+			args: args{
+				args: []string{"--install", "--scope", "local"},
+			},
+			want: want{
+				opts: hook.Options{
+					Install: true,
+					Scope:   hook.ScopeLocal,
+				},
+			},
+		},
+		{
+			name: "scope_invalid",
+			args: args{
+				args: []string{"--install", "--scope", "system"},
+			},
+		},
+		{
+			name: "status",
+			args: args{
+				args: []string{"--status"},
+				statuses: []hook.Status{
+					{
+						Scope:      hook.ScopeGlobal,
+						Location:   "/hooks/prepare-commit-msg",
+						Active:     true,
+						Exists:     true,
+						Managed:    true,
+						Executable: true,
+						Outdated:   true,
+					},
+					{
+						Scope:    hook.ScopeGlobal,
+						Location: "/hooks/commit-msg",
+						Active:   true,
+					},
+					{
+						Scope:      hook.ScopeLocal,
+						Location:   "/repo/.git/hooks/prepare-commit-msg",
+						Exists:     true,
+						Executable: true,
+						Chained:    true,
+					},
+				},
+			},
+			want: want{
+				opts: hook.Options{
+					Status: true,
+				},
+			},
+		},
+		{
+			name: "status_error",
+			args: args{
+				args: []string{"--status"},
+				err:  errMock,
+			},
+			want: want{
+				opts: hook.Options{
+					Status: true,
+				},
+				err: "error",
+			},
+		},
 		{
 			name: "hook_invalid",
 			args: args{
@@ -104,7 +201,8 @@ func TestHookCmd(t *testing.T) {
 			mlog := NewMockLogger(&buf)
 
 			h := MockHook{
-				err: tt.args.err,
+				statuses: tt.args.statuses,
+				err:      tt.args.err,
 			}
 
 			a := cmd.App{
@@ -189,6 +287,29 @@ func TestNewHookCmdFlags(t *testing.T) {
 				err: false,
 			},
 		},
+		{
+			name: "validate_flag",
+			args: "--install --validate",
+			want: want{
+				flags: map[string]flag{
+					"install": {
+						shorthand:   "",
+						value:       "true",
+						defValue:    "false",
+						changed:     true,
+						noOptDefVal: "true",
+					},
+					"validate": {
+						shorthand:   "",
+						value:       "true",
+						defValue:    "false",
+						changed:     true,
+						noOptDefVal: "true",
+					},
+				},
+				err: false,
+			},
+		},
 		{
 			name: "hook_invalid",
 			args: "--invalid",
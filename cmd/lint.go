@@ -0,0 +1,60 @@
+package cmd
+
+import (
+	"github.com/mikelorant/committed/internal/lint"
+
+	"github.com/spf13/cobra"
+)
+
+func NewLintCmd(a App) *cobra.Command {
+	var (
+		lintOptions lint.Options
+		format      string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "lint [revision range]",
+		Short: "Lint commit messages",
+		Long: "Lint a commit message file or the commits in a revision range.\n" +
+			"Exits with a non-zero status when any message has errors.",
+		Example: "  committed lint --file .git/COMMIT_EDITMSG\n" +
+			"  committed lint origin/main..HEAD",
+		Args: cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			f, err := lint.ParseFormat(format)
+			if err != nil {
+				a.Logger.Fatalf("Invalid output format: %v", format)
+
+				return
+			}
+
+			if len(args) > 0 {
+				lintOptions.Revision = args[0]
+			}
+
+			rs, err := a.Linter.Do(lintOptions)
+			if err != nil {
+				a.Logger.Fatalf("Unable to lint commit messages: %v", err)
+
+				return
+			}
+
+			if err := lint.Write(a.Writer, rs, f); err != nil {
+				a.Logger.Fatalf("Unable to write lint results: %v", err)
+
+				return
+			}
+
+			if lint.Failed(rs) {
+				a.Logger.Fatalf("Commit messages have errors.")
+			}
+		},
+	}
+
+	cmd.Flags().SortFlags = false
+	cmd.Flags().StringVarP(&lintOptions.File, "file", "", "", "Commit message file to lint")
+	cmd.Flags().StringVarP(&format, "format", "", "text", "Output format (text, json, github)")
+	cmd.Flags().StringVarP(&lintOptions.ConfigFile, "config", "", defaultConfigFile, "Config file location")
+
+	return cmd
+}
@@ -0,0 +1,168 @@
+package cmd_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/mikelorant/committed/cmd"
+	"github.com/mikelorant/committed/internal/config"
+	"github.com/mikelorant/committed/internal/lint"
+
+	"github.com/hexops/autogold/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+type MockLint struct {
+	opts    lint.Options
+	reports []lint.Report
+	err     error
+}
+
+func (l *MockLint) Do(opts lint.Options) ([]lint.Report, error) {
+	l.opts = opts
+
+	return l.reports, l.err
+}
+
+func TestLintCmd(t *testing.T) {
+	warning := []lint.Report{
+		{
+			Name:    "0a1b2c3",
+			Subject: "Summary.",
+			Results: []lint.Result{
+				{
+					Rule:     "summary-period",
+					Severity: config.SeverityWarning,
+					Problem:  lint.Problem{Line: 1, Column: 8, Message: "Summary should not end with a period."},
+				},
+			},
+		},
+	}
+
+	failed := []lint.Report{
+		{
+			Name:    ".git/COMMIT_EDITMSG",
+			Subject: "Summary",
+			File:    true,
+			Results: []lint.Result{
+				{
+					Rule:     "blank-line",
+					Severity: config.SeverityError,
+					Problem:  lint.Problem{Line: 2, Column: 1, Message: "Summary should be followed by a blank line."},
+				},
+			},
+		},
+	}
+
+	type args struct {
+		args    []string
+		reports []lint.Report
+		err     error
+	}
+
+	type want struct {
+		opts lint.Options
+	}
+
+	tests := []struct {
+		name string
+		args args
+		want want
+	}{
+		{
+			name: "lint_help",
+			args: args{
+				args: []string{"--help"},
+			},
+		},
+		{
+			name: "lint_revision",
+			args: args{
+				args:    []string{"--config", "config.yaml", "main..HEAD"},
+				reports: warning,
+			},
+			want: want{
+				opts: lint.Options{
+					ConfigFile: "config.yaml",
+					Revision:   "main..HEAD",
+				},
+			},
+		},
+		{
+			name: "lint_file",
+			args: args{
+				args:    []string{"--config", "config.yaml", "--file", ".git/COMMIT_EDITMSG"},
+				reports: failed,
+			},
+			want: want{
+				opts: lint.Options{
+					ConfigFile: "config.yaml",
+					File:       ".git/COMMIT_EDITMSG",
+				},
+			},
+		},
+		{
+			name: "lint_github",
+			args: args{
+				args:    []string{"--config", "config.yaml", "--format", "github", "--file", ".git/COMMIT_EDITMSG"},
+				reports: failed,
+			},
+			want: want{
+				opts: lint.Options{
+					ConfigFile: "config.yaml",
+					File:       ".git/COMMIT_EDITMSG",
+				},
+			},
+		},
+		{
+			name: "lint_format_invalid",
+			args: args{
+				args: []string{"--format", "xml"},
+			},
+		},
+		{
+			name: "lint_error",
+			args: args{
+				args: []string{"--config", "config.yaml"},
+				err:  errMock,
+			},
+			want: want{
+				opts: lint.Options{
+					ConfigFile: "config.yaml",
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+
+			mlog := NewMockLogger(&buf)
+
+			l := MockLint{
+				reports: tt.args.reports,
+				err:     tt.args.err,
+			}
+
+			a := cmd.App{
+				Linter: &l,
+				Logger: mlog,
+				Writer: &buf,
+			}
+
+			lcmd := cmd.NewLintCmd(a)
+
+			lcmd.SetOut(&buf)
+			lcmd.SetErr(&buf)
+			lcmd.SetArgs(tt.args.args)
+
+			lcmd.Execute()
+
+			assert.Equal(t, tt.want.opts, l.opts)
+
+			output := stripString(buf.String())
+			autogold.ExpectFile(t, autogold.Raw(output), autogold.Name(tt.name))
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"io"
 	"strings"
 
+	"github.com/mikelorant/committed/internal/commit"
 	"github.com/mikelorant/committed/internal/config"
 	"github.com/mikelorant/committed/internal/emoji"
 	"github.com/mikelorant/committed/internal/theme"
@@ -15,28 +16,41 @@ import (
 	"github.com/spf13/cobra"
 )
 
-func NewListCmd(w io.Writer) *cobra.Command {
+func NewListCmd(a App) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "list",
 		Short: "List settings with profiles or IDs",
 	}
 
-	cmd.AddCommand(NewListThemesCmd(w))
-	cmd.AddCommand(NewListEmojiProfilesCmd(w))
+	cmd.AddCommand(NewListThemesCmd(a))
+	cmd.AddCommand(NewListEmojiProfilesCmd(a.Writer))
+	cmd.AddCommand(NewListProfilesCmd(a))
 
 	return cmd
 }
 
-func NewListThemesCmd(w io.Writer) *cobra.Command {
+func NewListThemesCmd(a App) *cobra.Command {
+	var opts commit.Options
+
 	cmd := &cobra.Command{
 		Use:   "themes",
 		Short: "List theme IDs",
 		Args:  cobra.NoArgs,
 		Run: func(cmd *cobra.Command, args []string) {
-			listThemes(w)
+			cfg, _, err := a.Configer.LoadConfig(opts)
+			if err != nil {
+				a.Logger.Fatalf("Unable to load config: %v", err)
+
+				return
+			}
+
+			listThemes(a.Writer, cfg)
 		},
 	}
 
+	cmd.Flags().SortFlags = false
+	cmd.Flags().StringVarP(&opts.ConfigFile, "config", "", defaultConfigFile, "Config file location")
+
 	return cmd
 }
 
@@ -53,8 +67,33 @@ func NewListEmojiProfilesCmd(w io.Writer) *cobra.Command {
 	return cmd
 }
 
-func listThemes(w io.Writer) {
-	th := theme.New(config.ColourAdaptive)
+func NewListProfilesCmd(a App) *cobra.Command {
+	var opts commit.Options
+
+	cmd := &cobra.Command{
+		Use:   "profiles",
+		Short: "List config profiles",
+		Args:  cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			cfg, _, err := a.Configer.LoadConfig(opts)
+			if err != nil {
+				a.Logger.Fatalf("Unable to load config: %v", err)
+
+				return
+			}
+
+			listProfiles(a.Writer, cfg)
+		},
+	}
+
+	cmd.Flags().SortFlags = false
+	cmd.Flags().StringVarP(&opts.ConfigFile, "config", "", defaultConfigFile, "Config file location")
+
+	return cmd
+}
+
+func listThemes(w io.Writer, cfg config.Config) {
+	th := theme.New(cfg.View.Colour, cfg.Themes...)
 
 	tbl := table.New("Name", "ID", "Palette")
 	tbl.WithHeaderFormatter(header(th.Registry))
@@ -85,6 +124,26 @@ func listEmojiProfiles(w io.Writer) {
 	tbl.Print()
 }
 
+func listProfiles(w io.Writer, cfg config.Config) {
+	th := theme.New(config.ColourAdaptive)
+
+	tbl := table.New("Profile", "Paths", "Remotes", "Active")
+	tbl.WithHeaderFormatter(header(th.Registry))
+	tbl.WithWidthFunc(lipgloss.Width)
+	tbl.WithWriter(w)
+
+	for _, p := range cfg.Profiles {
+		var active string
+		if p.Name == cfg.Profile {
+			active = "yes"
+		}
+
+		tbl.AddRow(p.Name, strings.Join(p.Match.Paths, ", "), strings.Join(p.Match.Remotes, ", "), active)
+	}
+
+	tbl.Print()
+}
+
 func header(reg *tint.Registry) func(format string, vals ...interface{}) string {
 	fg := reg.BrightBlack()
 	if lipgloss.HasDarkBackground() {
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/mikelorant/committed/cmd"
+	"github.com/mikelorant/committed/internal/config"
 
 	"github.com/hexops/autogold/v2"
 )
@@ -28,7 +29,7 @@ func TestListCmd(t *testing.T) {
 
 			var buf bytes.Buffer
 
-			list := cmd.NewListCmd(&buf)
+			list := cmd.NewListCmd(cmd.App{Writer: &buf})
 			list.SetOut(&buf)
 			list.SetErr(&buf)
 			list.SetArgs([]string{})
@@ -74,10 +75,26 @@ func TestListThemeCmd(t *testing.T) {
 
 	tests := []struct {
 		name string
+		cfg  config.Config
+		err  error
 	}{
 		{
 			name: "list_theme_arg",
 		},
+		{
+			name: "list_theme_custom",
+			cfg: config.Config{
+				View: config.View{Colour: config.ColourDark},
+				Themes: []config.CustomTheme{
+					{Name: "acme", Accent: "#0a84ff"},
+					{Name: "latte", Colour: config.ColourLight},
+				},
+			},
+		},
+		{
+			name: "list_theme_error",
+			err:  errMock,
+		},
 	}
 
 	for _, tt := range tests {
@@ -88,7 +105,13 @@ func TestListThemeCmd(t *testing.T) {
 
 			var buf bytes.Buffer
 
-			list := cmd.NewListThemesCmd(&buf)
+			a := cmd.App{
+				Configer: &MockConfig{cfg: tt.cfg, err: tt.err},
+				Logger:   NewMockLogger(&buf),
+				Writer:   &buf,
+			}
+
+			list := cmd.NewListThemesCmd(a)
 			list.SetOut(&buf)
 			list.SetErr(&buf)
 			list.SetArgs([]string{})
@@ -98,3 +121,58 @@ func TestListThemeCmd(t *testing.T) {
 		})
 	}
 }
+
+func TestListProfilesCmd(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  config.Config
+		err  error
+	}{
+		{
+			name: "list_profiles",
+			cfg: config.Config{
+				Profiles: []config.Profile{
+					{
+						Name:  "work",
+						Match: config.Match{Paths: []string{"~/work"}, Remotes: []string{"*github.com?acme/*"}},
+					},
+					{
+						Name: "oss",
+					},
+				},
+				Profile: "work",
+			},
+		},
+		{
+			name: "list_profiles_error",
+			err:  errMock,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+
+			c := MockConfig{
+				cfg: tt.cfg,
+				err: tt.err,
+			}
+
+			a := cmd.App{
+				Configer: &c,
+				Logger:   NewMockLogger(&buf),
+				Writer:   &buf,
+			}
+
+			list := cmd.NewListProfilesCmd(a)
+			list.SetOut(&buf)
+			list.SetErr(&buf)
+			list.SetArgs([]string{"--"})
+
+			list.Execute()
+
+			output := stripString(buf.String())
+			autogold.ExpectFile(t, autogold.Raw(output), autogold.Name(tt.name))
+		})
+	}
+}
@@ -8,7 +8,9 @@ import (
 	"os"
 
 	"github.com/mikelorant/committed/internal/commit"
+	"github.com/mikelorant/committed/internal/config"
 	"github.com/mikelorant/committed/internal/hook"
+	"github.com/mikelorant/committed/internal/lint"
 	"github.com/mikelorant/committed/internal/ui"
 
 	"github.com/go-git/go-git/v5"
@@ -32,6 +34,23 @@ type Logger interface {
 
 type Hooker interface {
 	Do(opts hook.Options) error
+	Status(opts hook.Options) ([]hook.Status, error)
+}
+
+type Linter interface {
+	Do(opts lint.Options) ([]lint.Report, error)
+}
+
+type Configer interface {
+	LoadConfig(opts commit.Options) (config.Config, config.Sources, error)
+	ConfigFile(opts commit.Options, repo bool) (string, error)
+	InitConfig(file string, force bool) error
+	GetConfig(opts commit.Options, key string) ([]config.Value, error)
+	SetConfig(file, key, value string) error
+	ValidateConfig(opts commit.Options) ([]config.Problem, error)
+	EditConfig(file string) error
+	MigrateConfig(file string) (int, error)
+	ConfigSchema() ([]byte, error)
 }
 
 type App struct {
@@ -40,6 +59,8 @@ type App struct {
 	Logger   Logger
 	Writer   io.Writer
 	Hooker   Hooker
+	Linter   Linter
+	Configer Configer
 
 	req  *commit.Request
 	opts commit.Options
@@ -50,12 +71,19 @@ type Options struct {
 	Hook bool
 }
 
+// defaultConfigFile is shown by the help and replaced by the global file
+// when the command runs, so the help does not depend on the environment.
+var defaultConfigFile = config.DefaultGlobalFile
+
 func NewRootCmd(a App) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:         "committed",
 		Short:       "Committed is a WYSIWYG Git commit editor",
 		Version:     version,
 		Annotations: annotations(),
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			return resolveConfigFile(cmd)
+		},
 		PreRunE: func(cmd *cobra.Command, args []string) error {
 			return a.configure(a.opts)
 		},
@@ -69,17 +97,23 @@ func NewRootCmd(a App) *cobra.Command {
 
 	var (
 		defaultDryRun       = isDryRun()
-		defaultConfigFile   = "$HOME/.config/committed/config.yaml"
 		defaultSnapshotFile = "$HOME/.local/state/committed/snapshot.yaml"
+		defaultUsageFile    = "$HOME/.local/state/committed/usage.yaml"
 	)
 
 	cmd.AddCommand(NewVersionCmd())
-	cmd.AddCommand(NewListCmd(a.Writer))
+	cmd.AddCommand(NewListCmd(a))
 	cmd.AddCommand(NewHookCmd(a))
+	cmd.AddCommand(NewLintCmd(a))
+	cmd.AddCommand(NewConfigCmd(a))
 	cmd.SetVersionTemplate(verTmpl)
 	cmd.Flags().SortFlags = false
 	cmd.Flags().StringVarP(&a.opts.ConfigFile, "config", "", defaultConfigFile, "Config file location")
+	cmd.Flags().StringArrayVarP(&a.opts.Overrides, "option", "", nil, "Override a setting (key=value)")
+	cmd.Flags().BoolVarP(&a.opts.Strict, "strict", "", false, "Reject unknown config keys")
+	cmd.Flags().StringVarP(&a.opts.Profile, "profile", "", "", "Config profile to apply")
 	cmd.Flags().StringVarP(&a.opts.SnapshotFile, "snapshot", "", defaultSnapshotFile, "Snapshot file location")
+	cmd.Flags().StringVarP(&a.opts.UsageFile, "usage", "", defaultUsageFile, "Emoji usage file location")
 	cmd.Flags().BoolVarP(&a.opts.DryRun, "dry-run", "", defaultDryRun, "Simulate applying a commit")
 	cmd.Flags().BoolVarP(&a.opts.Amend, "amend", "a", false, "Replace the tip of the current branch by creating a new commit")
 	cmd.Flags().StringVarP(&a.opts.File.MessageFile, "editor", "", "", "")
@@ -107,6 +141,21 @@ func NewRootCmd(a App) *cobra.Command {
 	return cmd
 }
 
+// resolveConfigFile sets the config file to the global file, respecting
+// $XDG_CONFIG_HOME, unless the flag is given.
+func resolveConfigFile(cmd *cobra.Command) error {
+	f := cmd.Flag("config")
+	if f == nil || f.Changed {
+		return nil
+	}
+
+	if err := f.Value.Set(config.GlobalFile()); err != nil {
+		return fmt.Errorf("unable to set config file: %w", err)
+	}
+
+	return nil
+}
+
 func Execute() {
 	if err := NewRootCmd(NewApp()).Execute(); err != nil {
 		fmt.Fprintf(os.Stderr, "error: %v\n", err)
@@ -117,13 +166,16 @@ func Execute() {
 func NewApp() App {
 	c := commit.New()
 	h := hook.New()
+	li := lint.NewCheck()
 	l := log.Default()
 	u := ui.New()
 	w := os.Stdout
 
 	return App{
 		Commiter: &c,
+		Configer: &c,
 		Hooker:   &h,
+		Linter:   &li,
 		Logger:   l,
 		UIer:     &u,
 		Writer:   w,
@@ -155,6 +155,62 @@ func TestNewRootCmd(t *testing.T) {
 	}
 }
 
+func TestNewRootCmdConfigFile(t *testing.T) {
+	tests := []struct {
+		name string
+		xdg  string
+		args []string
+		want string
+	}{
+		{
+			name: "default",
+			args: []string{"config", "path"},
+			want: "$HOME/.config/committed/config.yaml",
+		},
+		{
+			name: "xdg",
+			xdg:  "/xdg",
+			args: []string{"config", "path"},
+			want: "$XDG_CONFIG_HOME/committed/config.yaml",
+		},
+		{
+			name: "flag",
+			xdg:  "/xdg",
+			args: []string{"config", "path", "--config", "config.yaml"},
+			want: "config.yaml",
+		},
+		{
+			name: "help",
+			xdg:  "/xdg",
+			args: []string{"--help"},
+			want: `(default "$HOME/.config/committed/config.yaml")`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("XDG_CONFIG_HOME", tt.xdg)
+
+			var buf bytes.Buffer
+
+			root := cmd.NewRootCmd(cmd.App{
+				Configer: &MockConfig{},
+				Logger:   NewMockLogger(&buf),
+				Writer:   &buf,
+			})
+
+			root.SetOut(&buf)
+			root.SetErr(&buf)
+			root.SetArgs(tt.args)
+
+			err := root.Execute()
+			assert.NoError(t, err)
+
+			assert.Contains(t, stripString(buf.String()), tt.want)
+		})
+	}
+}
+
 func TestNewRootCmdFlags(t *testing.T) {
 	type flag struct {
 		shorthand   string
@@ -234,6 +290,54 @@ func TestNewRootCmdFlags(t *testing.T) {
 				err: false,
 			},
 		},
+		{
+			name: "option_flag",
+			args: "--option view.theme=nord",
+			want: want{
+				flags: map[string]flag{
+					"option": {
+						shorthand:   "",
+						value:       "[view.theme=nord]",
+						defValue:    "[]",
+						changed:     true,
+						noOptDefVal: "",
+					},
+				},
+				err: false,
+			},
+		},
+		{
+			name: "strict_flag",
+			args: "--strict",
+			want: want{
+				flags: map[string]flag{
+					"strict": {
+						shorthand:   "",
+						value:       "true",
+						defValue:    "false",
+						changed:     true,
+						noOptDefVal: "true",
+					},
+				},
+				err: false,
+			},
+		},
+		{
+			name: "profile_flag",
+			args: "--profile work",
+			want: want{
+				flags: map[string]flag{
+					"profile": {
+						shorthand:   "",
+						value:       "work",
+						defValue:    "",
+						changed:     true,
+						noOptDefVal: "",
+					},
+				},
+				err: false,
+			},
+		},
 		{
 			name: "hook_flag",
 			args: "--hook",
@@ -16,11 +16,9 @@ require (
 	github.com/goccy/go-yaml v1.9.8
 	github.com/hexops/autogold/v2 v2.0.2
 	github.com/ivanpirog/coloredcobra v1.0.1
-	github.com/lithammer/fuzzysearch v1.1.5
 	github.com/lrstanley/bubbletint v0.0.0-20221222153826-8c18bc6ecfd0
 	github.com/mattn/go-runewidth v0.0.14
 	github.com/muesli/gamut v0.3.1
-	github.com/muesli/reflow v0.3.0
 	github.com/muesli/termenv v0.14.0
 	github.com/rodaine/table v1.1.0
 	github.com/spf13/cobra v1.6.1
@@ -64,6 +62,7 @@ require (
 	github.com/muesli/cancelreader v0.2.2 // indirect
 	github.com/muesli/clusters v0.0.0-20200529215643-2700303c1762 // indirect
 	github.com/muesli/kmeans v0.3.1 // indirect
+	github.com/muesli/reflow v0.3.0 // indirect
 	github.com/nightlyone/lockfile v1.0.0 // indirect
 	github.com/pjbgf/sha1cd v0.2.3 // indirect
 	github.com/pmezard/go-difflib v1.0.0 // indirect
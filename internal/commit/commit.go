@@ -8,11 +8,15 @@ import (
 	"os"
 	"os/exec"
 	"strings"
+	"time"
 
 	"github.com/mikelorant/committed/internal/config"
 	"github.com/mikelorant/committed/internal/emoji"
+	"github.com/mikelorant/committed/internal/keymap"
 	"github.com/mikelorant/committed/internal/repository"
+	"github.com/mikelorant/committed/internal/shell"
 	"github.com/mikelorant/committed/internal/snapshot"
+	"github.com/mikelorant/committed/internal/usage"
 )
 
 type Commit struct {
@@ -22,9 +26,20 @@ type Commit struct {
 	Snapshotter Snapshotter
 	Opener      Opener
 	ReadFiler   ReadFiler
+	ReadDirer   ReadDirer
 	Repoer      Repoer
+	Rooter      Rooter
+	Remoter     Remoter
+	GitConfiger GitConfiger
+	Environer   Environer
 	Creator     Creator
+	Editor      Editor
 	Saver       Saver
+	Usager      Usager
+	Nower       Nower
+
+	usage usage.Usage
+	root  string
 }
 
 type (
@@ -44,7 +59,7 @@ type Repoer interface {
 }
 
 type Configer interface {
-	Load(io.Reader) (config.Config, error)
+	Merge(config.Options, ...config.Input) (config.Config, config.Sources, error)
 	Save(io.WriteCloser, config.Config) error
 }
 
@@ -55,7 +70,11 @@ type Snapshotter interface {
 
 type Options struct {
 	ConfigFile   string
+	Overrides    []string
+	Strict       bool
+	Profile      string
 	SnapshotFile string
+	UsageFile    string
 	DryRun       bool
 	Amend        bool
 	Mode         Mode
@@ -71,15 +90,21 @@ type FileOptions struct {
 type Request struct {
 	Apply       bool
 	Emoji       string
+	Shortcode   string
 	Summary     string
+	Type        string
+	Scope       string
+	Breaking    bool
 	Body        string
 	RawBody     string
-	Footer      string
+	Trailers    []repository.Trailer
+	RawTrailers []repository.Trailer
 	Author      repository.User
 	Amend       bool
 	DryRun      bool
 	File        bool
 	MessageFile string
+	Favourites  []string
 }
 
 type Mode int
@@ -99,12 +124,20 @@ func New() Commit {
 		Snapshotter: new(snapshot.Snapshot),
 		Opener:      FileOpen(),
 		ReadFiler:   os.ReadFile,
+		ReadDirer:   os.ReadDir,
+		Rooter:      WorktreeRoot,
+		Remoter:     RemoteURLs,
+		GitConfiger: GitConfigs,
+		Environer:   os.Environ,
 		Creator:     FileCreate(),
+		Editor:      shell.Edit,
+		Usager:      new(usage.Usage),
+		Nower:       time.Now,
 	}
 }
 
 func (c *Commit) Configure(opts Options) (*State, error) {
-	cfg, err := getConfig(c.Opener, c.Configer, opts.ConfigFile)
+	cfg, _, err := c.LoadConfig(opts)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get config: %w", err)
 	}
@@ -119,7 +152,8 @@ func (c *Commit) Configure(opts Options) (*State, error) {
 	}
 
 	if !FileExists(opts.ConfigFile) {
-		if err := setConfig(c.Creator, c.Configer, opts.ConfigFile, cfg); err != nil {
+		// Only the global layer is written so other layers are not copied.
+		if err := setConfig(c.Creator, c.Configer, opts.ConfigFile, config.Config{}); err != nil {
 			return nil, fmt.Errorf("unable to set config: %w", err)
 		}
 	}
@@ -141,16 +175,35 @@ func (c *Commit) Configure(opts Options) (*State, error) {
 		opts.Amend = true
 	}
 
+	emojis, err := c.LoadEmojis(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get emojis: %w", err)
+	}
+
+	ranked, favourites, err := c.rankEmojis(opts, emojis.Emojis)
+	if err != nil {
+		return nil, fmt.Errorf("unable to rank emojis: %w", err)
+	}
+
+	emojis.Emojis = ranked
+
+	km, err := keymap.New(cfg.Keys)
+	if err != nil {
+		return nil, fmt.Errorf("unable to configure keys: %w", err)
+	}
+
 	c.Options = opts
 
 	return &State{
 		Placeholders: placeholders(),
-		Emojis:       getEmojis(c.Emojier, cfg),
+		Emojis:       emojis,
+		Favourites:   favourites,
 		Repository:   repo,
 		Config:       cfg,
 		Snapshot:     snap,
 		Options:      opts,
 		File:         file,
+		Keymap:       km,
 	}, nil
 }
 
@@ -161,9 +214,9 @@ func (c *Commit) Apply(req *Request) error {
 
 	com := repository.Commit{
 		Author:      UserToAuthor(req.Author),
-		Subject:     EmojiSummaryToSubject(req.Emoji, req.Summary),
+		Subject:     EmojiSummaryToSubject(req.Emoji, req.Summary, requestToConventional(req)),
 		Body:        req.Body,
-		Footer:      req.Footer,
+		Trailers:    req.Trailers,
 		Amend:       req.Amend,
 		DryRun:      req.DryRun,
 		File:        req.File,
@@ -171,12 +224,15 @@ func (c *Commit) Apply(req *Request) error {
 	}
 
 	snap := snapshot.Snapshot{
-		Emoji:   req.Emoji,
-		Summary: req.Summary,
-		Body:    req.RawBody,
-		Footer:  req.Footer,
-		Author:  req.Author,
-		Amend:   req.Amend,
+		Emoji:    req.Emoji,
+		Summary:  req.Summary,
+		Type:     req.Type,
+		Scope:    req.Scope,
+		Breaking: req.Breaking,
+		Body:     req.RawBody,
+		Trailers: req.RawTrailers,
+		Author:   req.Author,
+		Amend:    req.Amend,
 	}
 
 	if !req.Apply {
@@ -184,7 +240,7 @@ func (c *Commit) Apply(req *Request) error {
 			return fmt.Errorf("unable to set snapshot: %w", err)
 		}
 
-		return nil
+		return c.saveUsage(req, false)
 	}
 
 	if err := c.Repoer.Apply(com); err != nil {
@@ -199,9 +255,19 @@ func (c *Commit) Apply(req *Request) error {
 		if err := setSnapshot(c.Creator, c.Snapshotter, c.Options.SnapshotFile, snap); err != nil {
 			return fmt.Errorf("unable to set snapshot: %w", err)
 		}
+
+		return c.saveUsage(req, false)
 	}
 
-	return nil
+	return c.saveUsage(req, true)
+}
+
+func requestToConventional(req *Request) Conventional {
+	return Conventional{
+		Type:     req.Type,
+		Scope:    req.Scope,
+		Breaking: req.Breaking,
+	}
 }
 
 func getRepo(repo Repoer) (repository.Description, error) {
@@ -217,18 +283,55 @@ func getRepo(repo Repoer) (repository.Description, error) {
 	return desc, nil
 }
 
-func getConfig(open Opener, configer Configer, file string) (config.Config, error) {
-	r, err := open(file)
+// LoadConfig merges the configuration layers and reports the source of
+// each value.
+func (c *Commit) LoadConfig(opts Options) (config.Config, config.Sources, error) {
+	ins, err := ConfigInputs(c.Opener, c.Rooter, c.GitConfiger, c.Environer, opts.ConfigFile, opts.Overrides)
+	if err != nil {
+		return config.Config{}, nil, err
+	}
+
+	copts, err := c.configOptions(opts)
 	if err != nil {
-		return config.Config{}, fmt.Errorf("unable to open config file: %v: %w", file, err)
+		return config.Config{}, nil, err
 	}
 
-	cfg, err := configer.Load(r)
+	cfg, srcs, err := c.Configer.Merge(copts, ins...)
 	if err != nil {
-		return config.Config{}, fmt.Errorf("unable to load config file: %w", err)
+		return config.Config{}, nil, fmt.Errorf("unable to load config file: %w", err)
 	}
 
-	return cfg, nil
+	return cfg, srcs, nil
+}
+
+// configOptions describes the worktree so a profile can be matched when
+// none is named.
+func (c *Commit) configOptions(opts Options) (config.Options, error) {
+	copts := ConfigOptions(opts.Strict)
+	copts.Profile = opts.Profile
+
+	if opts.Profile != "" {
+		return copts, nil
+	}
+
+	dir, err := c.Rooter()
+	if err != nil {
+		return config.Options{}, fmt.Errorf("unable to find worktree root: %w", err)
+	}
+
+	if dir == "" {
+		return copts, nil
+	}
+
+	urls, err := c.Remoter(dir)
+	if err != nil {
+		return config.Options{}, fmt.Errorf("unable to get remote URLs: %w", err)
+	}
+
+	copts.Path = dir
+	copts.Remotes = urls
+
+	return copts, nil
 }
 
 func setConfig(create Creator, configer Configer, file string, cfg config.Config) error {
@@ -271,13 +374,6 @@ func setSnapshot(create Creator, snapshotter Snapshotter, file string, snap snap
 	return nil
 }
 
-func getEmojis(emojier Emojier, cfg config.Config) *emoji.Set {
-	prof := EmojiConfigToEmojiProfile(cfg.View.EmojiSet)
-	fn := emoji.WithEmojiSet(prof)
-
-	return emojier(fn)
-}
-
 func readFile(readFile ReadFiler, opts Options) (File, error) {
 	data, err := readFile(opts.File.MessageFile)
 	if err != nil {
@@ -288,6 +384,20 @@ func readFile(readFile ReadFiler, opts Options) (File, error) {
 
 	f := File{
 		Message: msg,
+		Source:  opts.File.Source,
+	}
+
+	switch opts.File.Source {
+	case SourceMerge:
+		f.Conflicts = MessageToConflicts(msg)
+
+		return f, nil
+	case SourceSquash:
+		f.Squash = MessageToSquash(msg)
+
+		return f, nil
+	case SourceTemplate:
+		return f, nil
 	}
 
 	if isAmend(msg, opts) {
@@ -13,6 +13,7 @@ import (
 	"github.com/mikelorant/committed/internal/repository"
 	"github.com/mikelorant/committed/internal/snapshot"
 
+	gitconfig "github.com/go-git/go-git/v5/plumbing/format/config"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -55,8 +56,8 @@ type MockConfig struct {
 	saveErr error
 }
 
-func (c *MockConfig) Load(fh io.Reader) (config.Config, error) {
-	return c.cfg, c.loadErr
+func (c *MockConfig) Merge(opts config.Options, ins ...config.Input) (config.Config, config.Sources, error) {
+	return c.cfg, nil, c.loadErr
 }
 
 func (c *MockConfig) Save(fh io.WriteCloser, cfg config.Config) error {
@@ -117,6 +118,24 @@ func MockCreate(err error) func(file string) (io.WriteCloser, error) {
 	}
 }
 
+func MockRoot(dir string, err error) func() (string, error) {
+	return func() (string, error) {
+		return dir, err
+	}
+}
+
+func MockGitConfig(cfgs ...*gitconfig.Config) func(string) ([]*gitconfig.Config, error) {
+	return func(string) ([]*gitconfig.Config, error) {
+		return cfgs, nil
+	}
+}
+
+func MockEnviron(env ...string) func() []string {
+	return func() []string {
+		return env
+	}
+}
+
 func MockReadFile(data string, err error) func(string) ([]byte, error) {
 	return func(string) ([]byte, error) {
 		if err != nil {
@@ -228,11 +247,6 @@ func TestConfigure(t *testing.T) {
 					},
 					Emojis: &emoji.Set{},
 				},
-				cfg: config.Config{
-					View: config.View{
-						Focus: config.FocusAuthor,
-					},
-				},
 			},
 		},
 		{
@@ -260,10 +274,10 @@ func TestConfigure(t *testing.T) {
 					SnapshotFile: "test",
 				},
 				snap: snapshot.Snapshot{
-					Emoji:   ":art:",
-					Summary: "summary",
-					Body:    "body",
-					Footer:  "footer",
+					Emoji:    ":art:",
+					Summary:  "summary",
+					Body:     "body",
+					Trailers: []repository.Trailer{{Key: "Fixes", Value: "#1"}},
 					Author: repository.User{
 						Name:  "John Doe",
 						Email: "john.doe@example.com",
@@ -276,10 +290,10 @@ func TestConfigure(t *testing.T) {
 					Config:       config.Config{},
 					Emojis:       &emoji.Set{},
 					Snapshot: snapshot.Snapshot{
-						Emoji:   ":art:",
-						Summary: "summary",
-						Body:    "body",
-						Footer:  "footer",
+						Emoji:    ":art:",
+						Summary:  "summary",
+						Body:     "body",
+						Trailers: []repository.Trailer{{Key: "Fixes", Value: "#1"}},
 						Author: repository.User{
 							Name:  "John Doe",
 							Email: "john.doe@example.com",
@@ -346,20 +360,110 @@ func TestConfigure(t *testing.T) {
 			},
 		},
 		{
-			name: "ignore_global_config",
+			name: "file_hook_merge",
 			args: args{
-				cfg: config.Config{
-					View: config.View{
-						IgnoreGlobalAuthor: true,
+				opts: commit.Options{
+					Mode: commit.ModeHook,
+					File: commit.FileOptions{
+						MessageFile: "test",
+						Source:      "merge",
 					},
 				},
+				data: "Merge branch 'test'\n\n# Conflicts:\n#\ttest.go\n",
 			},
 			want: want{
+				state: commit.State{
+					Placeholders: testPlaceholders(),
+					Config:       config.Config{},
+					Emojis:       &emoji.Set{},
+					Options: commit.Options{
+						Mode: commit.ModeHook,
+						File: commit.FileOptions{
+							MessageFile: "test",
+							Source:      "merge",
+						},
+					},
+					File: commit.File{
+						Message:   "Merge branch 'test'\n\n# Conflicts:\n#\ttest.go\n",
+						Source:    "merge",
+						Conflicts: []string{"test.go"},
+					},
+				},
+			},
+		},
+		{
+			name: "file_hook_squash",
+			args: args{
+				opts: commit.Options{
+					Mode: commit.ModeHook,
+					File: commit.FileOptions{
+						MessageFile: "test",
+						Source:      "squash",
+					},
+				},
+				data: "Squashed commit of the following:\n\ncommit 1\nAuthor: John Doe <john.doe@example.com>\n\n    test\n",
+			},
+			want: want{
+				state: commit.State{
+					Placeholders: testPlaceholders(),
+					Config:       config.Config{},
+					Emojis:       &emoji.Set{},
+					Options: commit.Options{
+						Mode: commit.ModeHook,
+						File: commit.FileOptions{
+							MessageFile: "test",
+							Source:      "squash",
+						},
+					},
+					File: commit.File{
+						Message: "Squashed commit of the following:\n\ncommit 1\nAuthor: John Doe <john.doe@example.com>\n\n    test\n",
+						Source:  "squash",
+						Squash:  []string{"test"},
+					},
+				},
+			},
+		},
+		{
+			name: "file_hook_template",
+			args: args{
+				opts: commit.Options{
+					Mode: commit.ModeHook,
+					File: commit.FileOptions{
+						MessageFile: "test",
+						Source:      "template",
+					},
+				},
+				data: "summary\n",
+			},
+			want: want{
+				state: commit.State{
+					Placeholders: testPlaceholders(),
+					Config:       config.Config{},
+					Emojis:       &emoji.Set{},
+					Options: commit.Options{
+						Mode: commit.ModeHook,
+						File: commit.FileOptions{
+							MessageFile: "test",
+							Source:      "template",
+						},
+					},
+					File: commit.File{
+						Message: "summary\n",
+						Source:  "template",
+					},
+				},
+			},
+		},
+		{
+			name: "ignore_global_config",
+			args: args{
 				cfg: config.Config{
 					View: config.View{
 						IgnoreGlobalAuthor: true,
 					},
 				},
+			},
+			want: want{
 				state: commit.State{
 					Config: config.Config{
 						View: config.View{
@@ -481,6 +585,9 @@ func TestConfigure(t *testing.T) {
 				Creator:     MockCreate(tt.args.createErr),
 				Opener:      MockOpen(tt.args.openErr),
 				ReadFiler:   MockReadFile(tt.args.data, tt.args.readFileErr),
+				Rooter:      MockRoot("", nil),
+				GitConfiger: MockGitConfig(),
+				Environer:   MockEnviron(),
 			}
 
 			state, err := c.Configure(tt.args.opts)
@@ -523,11 +630,11 @@ func TestApply(t *testing.T) {
 			name: "normal",
 			args: args{
 				req: &commit.Request{
-					Apply:   true,
-					Emoji:   ":art:",
-					Summary: "summary",
-					Body:    "body",
-					Footer:  "Signed-off-by: John Doe <john.doe@example.com>",
+					Apply:    true,
+					Emoji:    ":art:",
+					Summary:  "summary",
+					Body:     "body",
+					Trailers: []repository.Trailer{{Key: "Fixes", Value: "#123"}},
 					Author: repository.User{
 						Name:  "John Doe",
 						Email: "john.doe@example.com",
@@ -536,10 +643,28 @@ func TestApply(t *testing.T) {
 			},
 			want: want{
 				cfg: repository.Commit{
-					Author:  "John Doe <john.doe@example.com>",
-					Subject: ":art: summary",
-					Body:    "body",
-					Footer:  "Signed-off-by: John Doe <john.doe@example.com>",
+					Author:   "John Doe <john.doe@example.com>",
+					Subject:  ":art: summary",
+					Body:     "body",
+					Trailers: []repository.Trailer{{Key: "Fixes", Value: "#123"}},
+				},
+			},
+		},
+		{
+			name: "conventional",
+			args: args{
+				req: &commit.Request{
+					Apply:    true,
+					Emoji:    ":art:",
+					Summary:  "summary",
+					Type:     "feat",
+					Scope:    "api",
+					Breaking: true,
+				},
+			},
+			want: want{
+				cfg: repository.Commit{
+					Subject: "feat(api)!: :art: summary",
 				},
 			},
 		},
@@ -658,7 +783,11 @@ func TestApply(t *testing.T) {
 					Emoji:   ":art:",
 					Summary: "summary",
 					RawBody: "body",
-					Footer:  "Signed-off-by: John Doe <john.doe@example.com>",
+					Trailers: []repository.Trailer{
+						{Key: "Fixes", Value: "#123"},
+						{Key: "Signed-off-by", Value: "John Doe <john.doe@example.com>"},
+					},
+					RawTrailers: []repository.Trailer{{Key: "Fixes", Value: "#123"}},
 					Author: repository.User{
 						Name:  "John Doe",
 						Email: "john.doe@example.com",
@@ -667,10 +796,10 @@ func TestApply(t *testing.T) {
 			},
 			want: want{
 				snapshot: snapshot.Snapshot{
-					Emoji:   ":art:",
-					Summary: "summary",
-					Body:    "body",
-					Footer:  "Signed-off-by: John Doe <john.doe@example.com>",
+					Emoji:    ":art:",
+					Summary:  "summary",
+					Body:     "body",
+					Trailers: []repository.Trailer{{Key: "Fixes", Value: "#123"}},
 					Author: repository.User{
 						Name:  "John Doe",
 						Email: "john.doe@example.com",
@@ -760,6 +889,5 @@ func testPlaceholders() commit.Placeholders {
 		Hash:    commit.PlaceholderHash,
 		Summary: commit.PlaceholderSummary,
 		Body:    commit.PlaceholderMessage,
-		Help:    commit.PlaceholderHelp,
 	}
 }
@@ -0,0 +1,199 @@
+package commit
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/mikelorant/committed/internal/config"
+)
+
+// Editor opens a file for editing and returns once it is closed.
+type Editor func(string) error
+
+var (
+	ErrConfigExists = errors.New("config file already exists")
+	ErrWorktree     = errors.New("not within a worktree")
+)
+
+// ConfigFile returns the location of the global config file or, for the
+// repository layer, the file at the root of the worktree.
+func (c *Commit) ConfigFile(opts Options, repo bool) (string, error) {
+	if !repo {
+		return os.ExpandEnv(opts.ConfigFile), nil
+	}
+
+	dir, err := c.Rooter()
+	if err != nil {
+		return "", fmt.Errorf("unable to find worktree root: %w", err)
+	}
+
+	if dir == "" {
+		return "", ErrWorktree
+	}
+
+	return filepath.Join(dir, config.RepositoryFile), nil
+}
+
+// InitConfig writes a config file documenting every setting. An existing
+// file is only replaced when forced.
+func (c *Commit) InitConfig(file string, force bool) error {
+	exists, err := c.configExists(file)
+	if err != nil {
+		return err
+	}
+
+	if exists && !force {
+		return fmt.Errorf("%w: %v", ErrConfigExists, file)
+	}
+
+	return writeConfig(c.Creator, file, []byte(config.DefaultFile))
+}
+
+// GetConfig returns the effective value of a key. A group such as "view"
+// returns every key within it.
+func (c *Commit) GetConfig(opts Options, key string) ([]config.Value, error) {
+	cfg, srcs, err := c.LoadConfig(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	vs, err := config.Values(cfg, srcs)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get config values: %w", err)
+	}
+
+	var res []config.Value
+
+	for _, v := range vs {
+		if v.Key == key || strings.HasPrefix(v.Key, key+".") {
+			res = append(res, v)
+		}
+	}
+
+	if len(res) == 0 {
+		return nil, fmt.Errorf("%w: %v", config.ErrKey, key)
+	}
+
+	return res, nil
+}
+
+// SetConfig changes the value of a key in a config file, keeping the
+// comments and other settings. The file is created when missing.
+func (c *Commit) SetConfig(file, key, value string) error {
+	src, err := c.ReadFiler(file)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("unable to read config file: %w", err)
+	}
+
+	out, err := config.Set(src, key, value, ConfigOptions(false))
+	if err != nil {
+		return fmt.Errorf("unable to set config value: %w", err)
+	}
+
+	return writeConfig(c.Creator, file, out)
+}
+
+// MigrateConfig upgrades a config file to the current version, keeping the
+// comments and settings. The version the file was upgraded from is
+// returned. A current file is left untouched.
+func (c *Commit) MigrateConfig(file string) (int, error) {
+	src, err := c.ReadFiler(file)
+	if err != nil {
+		return 0, fmt.Errorf("unable to read config file: %w", err)
+	}
+
+	out, from, err := config.Migrate(src)
+	if err != nil {
+		return 0, fmt.Errorf("unable to migrate config: %w", err)
+	}
+
+	if from == config.Version {
+		return from, nil
+	}
+
+	return from, writeConfig(c.Creator, file, out)
+}
+
+// ConfigSchema returns a JSON Schema describing a config file.
+func (c *Commit) ConfigSchema() ([]byte, error) {
+	out, err := config.Schema(ConfigOptions(false))
+	if err != nil {
+		return nil, fmt.Errorf("unable to generate schema: %w", err)
+	}
+
+	return out, nil
+}
+
+// ValidateConfig checks the config files, git config and environment
+// variables for unknown keys and invalid values. Validation is always
+// strict.
+func (c *Commit) ValidateConfig(opts Options) ([]config.Problem, error) {
+	ins, err := ConfigInputs(c.Opener, c.Rooter, c.GitConfiger, c.Environer, opts.ConfigFile, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	ps, err := config.ValidateInputs(ConfigOptions(opts.Strict), ins...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to validate config: %w", err)
+	}
+
+	// Unknown environment variables are only reported when strict.
+	if !opts.Strict {
+		return ps, nil
+	}
+
+	return append(ps, config.ValidateEnvironment(c.Environer())...), nil
+}
+
+// EditConfig opens a config file in the editor, initialising it first when
+// missing.
+func (c *Commit) EditConfig(file string) error {
+	exists, err := c.configExists(file)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		if err := c.InitConfig(file, false); err != nil {
+			return err
+		}
+	}
+
+	if err := c.Editor(file); err != nil {
+		return fmt.Errorf("unable to edit config file: %w", err)
+	}
+
+	return nil
+}
+
+func (c *Commit) configExists(file string) (bool, error) {
+	_, err := c.ReadFiler(file)
+
+	switch {
+	case err == nil:
+		return true, nil
+	case errors.Is(err, fs.ErrNotExist):
+		return false, nil
+	default:
+		return false, fmt.Errorf("unable to read config file: %w", err)
+	}
+}
+
+func writeConfig(create Creator, file string, data []byte) error {
+	w, err := create(file)
+	if err != nil {
+		return fmt.Errorf("unable to create config: %w", err)
+	}
+	defer w.Close()
+
+	if _, err := w.Write(data); err != nil {
+		return fmt.Errorf("unable to write config: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,595 @@
+package commit_test
+
+import (
+	"bytes"
+	"io"
+	"io/fs"
+	"strings"
+	"testing"
+
+	"github.com/mikelorant/committed/internal/commit"
+	"github.com/mikelorant/committed/internal/config"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type bufferCloser struct {
+	*bytes.Buffer
+}
+
+func (bufferCloser) Close() error {
+	return nil
+}
+
+func mockBufferCreate(buf *bytes.Buffer) func(string) (io.WriteCloser, error) {
+	return func(string) (io.WriteCloser, error) {
+		return bufferCloser{buf}, nil
+	}
+}
+
+func TestConfigFile(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		root string
+		repo bool
+		want string
+		err  string
+	}{
+		{
+			name: "global",
+			want: "/config.yaml",
+		},
+		{
+			name: "repository",
+			root: "/repo",
+			repo: true,
+			want: "/repo/.committed.yaml",
+		},
+		{
+			name: "no_worktree",
+			repo: true,
+			err:  "not within a worktree",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			c := commit.Commit{Rooter: MockRoot(tt.root, nil)}
+
+			got, err := c.ConfigFile(commit.Options{ConfigFile: "/config.yaml"}, tt.repo)
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+				return
+			}
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestInitConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		readErr error
+		force   bool
+		want    string
+		err     string
+	}{
+		{
+			name:    "missing",
+			readErr: fs.ErrNotExist,
+			want:    config.DefaultFile,
+		},
+		{
+			name: "exists",
+			err:  "config file already exists: config.yaml",
+		},
+		{
+			name:  "force",
+			force: true,
+			want:  config.DefaultFile,
+		},
+		{
+			name:    "read_error",
+			readErr: errMock,
+			err:     "unable to read config file: error",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var buf bytes.Buffer
+
+			c := commit.Commit{
+				ReadFiler: MockReadFile("view: {theme: nord}", tt.readErr),
+				Creator:   mockBufferCreate(&buf),
+			}
+
+			err := c.InitConfig("config.yaml", tt.force)
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+				return
+			}
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.want, buf.String())
+		})
+	}
+}
+
+func TestGetConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		key  string
+		want []string
+		err  string
+	}{
+		{
+			name: "key",
+			key:  "commit.signoff",
+			want: []string{"commit.signoff=true"},
+		},
+		{
+			name: "group",
+			key:  "view",
+			want: []string{
+				"view.focus=emoji",
+				"view.emojiSet=gitmoji",
+				"view.emojiSelector=below",
+				"view.compatibility=default",
+				"view.theme=nord",
+				"view.colour=adaptive",
+				"view.editMode=default",
+				"view.highlightActive=false",
+				"view.ignoreGlobalAuthor=false",
+			},
+		},
+		{
+			name: "default_limit",
+			key:  "lint.summaryLimit",
+			want: []string{"lint.summaryLimit=50"},
+		},
+		{
+			name: "default_severity",
+			key:  "lint.summaryCapital",
+			want: []string{"lint.summaryCapital=warning"},
+		},
+		{
+			name: "unknown",
+			key:  "view.unknown",
+			err:  "unknown key: view.unknown",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			c := commit.Commit{
+				Configer:    new(config.Config),
+				Opener:      MockOpen(nil),
+				Rooter:      MockRoot("", nil),
+				GitConfiger: MockGitConfig(),
+				Environer:   MockEnviron(),
+			}
+
+			opts := commit.Options{
+				Overrides: []string{"view.theme=nord", "commit.signoff=true"},
+			}
+
+			vs, err := c.GetConfig(opts, tt.key)
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+				return
+			}
+			assert.NoError(t, err)
+
+			var got []string
+			for _, v := range vs {
+				got = append(got, v.Key+"="+v.Value)
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSetConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		file    string
+		readErr error
+		want    string
+		err     string
+	}{
+		{
+			name:    "missing",
+			readErr: fs.ErrNotExist,
+			want:    "view:\n  theme: nord\n",
+		},
+		{
+			name: "existing",
+			file: "# Settings.\ncommit:\n  signoff: true\n",
+			want: "# Settings.\ncommit:\n  signoff: true\nview:\n  theme: nord\n",
+		},
+		{
+			name:    "read_error",
+			readErr: errMock,
+			err:     "unable to read config file: error",
+		},
+		{
+			name: "invalid",
+			file: "invalid",
+			err:  "unable to set config value: config must be a mapping",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var buf bytes.Buffer
+
+			c := commit.Commit{
+				ReadFiler: MockReadFile(tt.file, tt.readErr),
+				Creator:   mockBufferCreate(&buf),
+			}
+
+			err := c.SetConfig("config.yaml", "view.theme", "nord")
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+				return
+			}
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.want, buf.String())
+		})
+	}
+}
+
+func TestMigrateConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		file    string
+		readErr error
+		version int
+		want    string
+		err     string
+	}{
+		{
+			name: "migrated",
+			file: "# Settings.\nview:\n  emojiset: devmoji\n",
+			want: "version: 1\n# Settings.\nview:\n  emojiSet: devmoji\n",
+		},
+		{
+			name:    "current",
+			file:    "version: 1\n",
+			version: 1,
+		},
+		{
+			name:    "missing",
+			readErr: fs.ErrNotExist,
+			err:     "unable to read config file: file does not exist",
+		},
+		{
+			name: "newer",
+			file: "version: 2\n",
+			err:  "unable to migrate config: unsupported config version: 2: newest supported version is 1",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var buf bytes.Buffer
+
+			c := commit.Commit{
+				ReadFiler: MockReadFile(tt.file, tt.readErr),
+				Creator:   mockBufferCreate(&buf),
+			}
+
+			v, err := c.MigrateConfig("config.yaml")
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+				return
+			}
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.version, v)
+			assert.Equal(t, tt.want, buf.String())
+		})
+	}
+}
+
+func TestConfigSchema(t *testing.T) {
+	t.Parallel()
+
+	c := commit.New()
+
+	out, err := c.ConfigSchema()
+	assert.NoError(t, err)
+
+	assert.Contains(t, string(out), `"builtin_dark"`)
+}
+
+func TestValidateConfig(t *testing.T) {
+	t.Parallel()
+
+	open := func(file string) (io.Reader, error) {
+		if file == "/repo/.committed.yaml" {
+			return strings.NewReader("view:\n  emojiSet: invalid\n"), nil
+		}
+
+		return strings.NewReader("unknown: true\n"), nil
+	}
+
+	tests := []struct {
+		name   string
+		strict bool
+		want   []string
+	}{
+		{
+			name: "default",
+			want: []string{
+				`/repo/.committed.yaml:2:13: view.emojiSet: invalid value, allowed values: gitmoji, devmoji, emojilog: "invalid"`,
+			},
+		},
+		{
+			name:   "strict",
+			strict: true,
+			want: []string{
+				"config.yaml:1:1: unknown: unknown key",
+				`/repo/.committed.yaml:2:13: view.emojiSet: invalid value, allowed values: gitmoji, devmoji, emojilog: "invalid"`,
+				"COMMITTED_UNKNOWN: unknown key",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			c := commit.Commit{
+				Opener:      open,
+				Rooter:      MockRoot("/repo", nil),
+				GitConfiger: MockGitConfig(),
+				Environer:   MockEnviron("COMMITTED_UNKNOWN=true"),
+			}
+
+			ps, err := c.ValidateConfig(commit.Options{ConfigFile: "config.yaml", Strict: tt.strict})
+			assert.NoError(t, err)
+
+			var got []string
+			for _, p := range ps {
+				got = append(got, p.String())
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestEditConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		readErr error
+		editErr error
+		want    string
+		err     string
+	}{
+		{
+			name: "existing",
+		},
+		{
+			name:    "missing",
+			readErr: fs.ErrNotExist,
+			want:    config.DefaultFile,
+		},
+		{
+			name:    "edit_error",
+			editErr: errMock,
+			err:     "unable to edit config file: error",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				buf    bytes.Buffer
+				edited string
+			)
+
+			c := commit.Commit{
+				ReadFiler: MockReadFile("", tt.readErr),
+				Creator:   mockBufferCreate(&buf),
+				Editor: func(file string) error {
+					edited = file
+
+					return tt.editErr
+				},
+			}
+
+			err := c.EditConfig("config.yaml")
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+				return
+			}
+			assert.NoError(t, err)
+
+			assert.Equal(t, "config.yaml", edited)
+			assert.Equal(t, tt.want, buf.String())
+		})
+	}
+}
+
+func TestLoadConfigValidation(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		config string
+		strict bool
+		err    string
+	}{
+		{
+			name:   "valid",
+			config: "view: {theme: nord, unknown: true}",
+		},
+		{
+			name:   "invalid_enum",
+			config: "view: {emojiSet: gitmojis}",
+			err:    `unable to load config file: invalid config: config.yaml:1:18: view.emojiSet: invalid value, allowed values: gitmoji, devmoji, emojilog: "gitmojis"`,
+		},
+		{
+			name:   "unknown_theme",
+			config: "view: {theme: nords}",
+			err:    "unable to load config file: invalid config: config.yaml:1:15: view.theme: invalid value, allowed values: ",
+		},
+		{
+			name:   "unknown_key_strict",
+			config: "view: {theme: nord, unknown: true}",
+			strict: true,
+			err:    "unable to load config file: invalid config: config.yaml:1:21: view.unknown: unknown key",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			c := commit.Commit{
+				Configer: new(config.Config),
+				Opener: func(string) (io.Reader, error) {
+					return strings.NewReader(tt.config), nil
+				},
+				Rooter:      MockRoot("", nil),
+				GitConfiger: MockGitConfig(),
+				Environer:   MockEnviron(),
+			}
+
+			_, _, err := c.LoadConfig(commit.Options{ConfigFile: "config.yaml", Strict: tt.strict})
+			if tt.err != "" {
+				assert.ErrorContains(t, err, tt.err)
+
+				var verr *config.ValidationError
+				assert.ErrorAs(t, err, &verr)
+
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestLoadConfigProfile(t *testing.T) {
+	t.Parallel()
+
+	global := "profiles: [{name: work, match: {remotes: ['*acme*']}}, {name: oss}]"
+
+	tests := []struct {
+		name      string
+		profile   string
+		root      string
+		remotes   []string
+		remoteErr error
+		want      string
+		err       string
+	}{
+		{
+			name: "outside_worktree",
+		},
+		{
+			name:    "named",
+			profile: "oss",
+			root:    "/src/api",
+			want:    "oss",
+		},
+		{
+			name:    "matched",
+			root:    "/src/api",
+			remotes: []string{"git@github.com:acme/api.git"},
+			want:    "work",
+		},
+		{
+			name:    "unmatched",
+			root:    "/src/api",
+			remotes: []string{"git@github.com:other/api.git"},
+		},
+		{
+			name:      "remote_error",
+			root:      "/src/api",
+			remoteErr: errMock,
+			err:       "unable to get remote URLs: error",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			c := commit.Commit{
+				Configer: new(config.Config),
+				Opener: func(file string) (io.Reader, error) {
+					if file == "config.yaml" {
+						return strings.NewReader(global), nil
+					}
+
+					return strings.NewReader(""), nil
+				},
+				Rooter:      MockRoot(tt.root, nil),
+				GitConfiger: MockGitConfig(),
+				Environer:   MockEnviron(),
+				Remoter: func(string) ([]string, error) {
+					return tt.remotes, tt.remoteErr
+				},
+			}
+
+			cfg, _, err := c.LoadConfig(commit.Options{ConfigFile: "config.yaml", Profile: tt.profile})
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+				return
+			}
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.want, cfg.Profile)
+		})
+	}
+}
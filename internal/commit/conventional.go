@@ -0,0 +1,148 @@
+package commit
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/mikelorant/committed/internal/config"
+	"github.com/mikelorant/committed/internal/emoji"
+)
+
+type Conventional struct {
+	Type     string
+	Scope    string
+	Breaking bool
+}
+
+var DefaultTypes = []string{
+	"feat",
+	"fix",
+	"docs",
+	"style",
+	"refactor",
+	"perf",
+	"test",
+	"build",
+	"ci",
+	"chore",
+	"revert",
+}
+
+var TypeDescriptions = map[string]string{
+	"feat":     "A new feature.",
+	"fix":      "A bug fix.",
+	"docs":     "Documentation only changes.",
+	"style":    "Changes that do not affect the meaning of the code.",
+	"refactor": "A code change that neither fixes a bug nor adds a feature.",
+	"perf":     "A code change that improves performance.",
+	"test":     "Adding missing tests or correcting existing tests.",
+	"build":    "Changes that affect the build system or external dependencies.",
+	"ci":       "Changes to CI configuration files and scripts.",
+	"chore":    "Other changes that don't modify source or test files.",
+	"revert":   "Reverts a previous commit.",
+}
+
+var conventionalPrefix = regexp.MustCompile(`^([a-z]+)(?:\(([^()]*)\))?(!)?: `)
+
+// Types returns the configured types or the default types when none are
+// configured.
+func Types(cfg config.Commit) []string {
+	if len(cfg.Types) > 0 {
+		return cfg.Types
+	}
+
+	return DefaultTypes
+}
+
+func ConventionalToPrefix(c Conventional) string {
+	if c.Type == "" {
+		return ""
+	}
+
+	var sb strings.Builder
+
+	sb.WriteString(c.Type)
+
+	if c.Scope != "" {
+		fmt.Fprintf(&sb, "(%s)", c.Scope)
+	}
+
+	if c.Breaking {
+		sb.WriteString("!")
+	}
+
+	sb.WriteString(":")
+
+	return sb.String()
+}
+
+// MessageToConventional returns the type, scope and breaking change of a
+// message. A prefix is only conventional when it starts with one of the
+// types, so an ordinary summary such as "update: config" is not split.
+func MessageToConventional(msg string, types []string) Conventional {
+	if !hasSummary(msg) {
+		return Conventional{}
+	}
+
+	line := TrimEmoji(strings.Split(msg, "\n")[0])
+
+	m := conventionalMatch(line, types)
+	if m == nil {
+		return Conventional{}
+	}
+
+	return Conventional{
+		Type:     submatch(line, m, 1),
+		Scope:    submatch(line, m, 2),
+		Breaking: m[6] != -1,
+	}
+}
+
+// TrimConventional removes a conventional prefix with one of the types from a
+// line.
+func TrimConventional(line string, types []string) string {
+	m := conventionalMatch(line, types)
+	if m == nil {
+		return line
+	}
+
+	return line[m[1]:]
+}
+
+// conventionalMatch returns the submatch indexes of a conventional prefix
+// with one of the types.
+func conventionalMatch(line string, types []string) []int {
+	m := conventionalPrefix.FindStringSubmatchIndex(line)
+	if m == nil {
+		return nil
+	}
+
+	typ := submatch(line, m, 1)
+
+	for _, t := range types {
+		if t == typ {
+			return m
+		}
+	}
+
+	return nil
+}
+
+func submatch(line string, m []int, i int) string {
+	if m[2*i] == -1 {
+		return ""
+	}
+
+	return line[m[2*i]:m[2*i+1]]
+}
+
+func TrimEmoji(line string) string {
+	ls := strings.Split(line, " ")
+
+	if !emoji.Has(ls[0]) {
+		return line
+	}
+
+	return strings.Join(ls[1:], " ")
+}
@@ -0,0 +1,134 @@
+package commit_test
+
+import (
+	"testing"
+
+	"github.com/mikelorant/committed/internal/commit"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConventionalToPrefix(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		conventional commit.Conventional
+		prefix       string
+	}{
+		{
+			name:         "type",
+			conventional: commit.Conventional{Type: "feat"},
+			prefix:       "feat:",
+		},
+		{
+			name:         "type_scope",
+			conventional: commit.Conventional{Type: "feat", Scope: "api"},
+			prefix:       "feat(api):",
+		},
+		{
+			name:         "type_breaking",
+			conventional: commit.Conventional{Type: "feat", Breaking: true},
+			prefix:       "feat!:",
+		},
+		{
+			name:         "type_scope_breaking",
+			conventional: commit.Conventional{Type: "feat", Scope: "api", Breaking: true},
+			prefix:       "feat(api)!:",
+		},
+		{
+			name:         "scope_only",
+			conventional: commit.Conventional{Scope: "api", Breaking: true},
+		},
+		{
+			name: "empty",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.prefix, commit.ConventionalToPrefix(tt.conventional))
+		})
+	}
+}
+
+func TestMessageToConventional(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		message      string
+		types        []string
+		conventional commit.Conventional
+	}{
+		{
+			name:         "type",
+			message:      "feat: summary",
+			conventional: commit.Conventional{Type: "feat"},
+		},
+		{
+			name:         "type_scope_breaking",
+			message:      "fix(ui)!: summary",
+			conventional: commit.Conventional{Type: "fix", Scope: "ui", Breaking: true},
+		},
+		{
+			name:         "emoji_type_scope",
+			message:      ":art: refactor(api): summary",
+			conventional: commit.Conventional{Type: "refactor", Scope: "api"},
+		},
+		{
+			name:         "multiline",
+			message:      "feat(api): summary\n\nbody\n",
+			conventional: commit.Conventional{Type: "feat", Scope: "api"},
+		},
+		{
+			name:    "unknown_type",
+			message: "update: summary",
+		},
+		{
+			name:         "configured_type",
+			message:      "task(api): summary",
+			types:        []string{"task"},
+			conventional: commit.Conventional{Type: "task", Scope: "api"},
+		},
+		{
+			name:    "unconfigured_type",
+			message: "feat: summary",
+			types:   []string{"task"},
+		},
+		{
+			name:    "no_space",
+			message: "feat:summary",
+		},
+		{
+			name:    "capitalised",
+			message: "Note: summary",
+		},
+		{
+			name:    "summary",
+			message: "summary",
+		},
+		{
+			name: "empty",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			types := tt.types
+			if types == nil {
+				types = commit.DefaultTypes
+			}
+
+			assert.Equal(t, tt.conventional, commit.MessageToConventional(tt.message, types))
+		})
+	}
+}
@@ -0,0 +1,110 @@
+package commit
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+
+	"github.com/mikelorant/committed/internal/config"
+	"github.com/mikelorant/committed/internal/emoji"
+)
+
+// ReadDirer lists the entries of a directory.
+type ReadDirer func(string) ([]fs.DirEntry, error)
+
+var ErrEmojiSet = errors.New("unknown emoji set")
+
+// emojiFileExts are the extensions of the emoji files read from a directory.
+var emojiFileExts = map[string]bool{
+	".yaml": true,
+	".yml":  true,
+}
+
+// LoadEmojis returns the emoji set selected by the config. A custom set adds
+// the emojis read from its paths to the built-in set it extends.
+func (c *Commit) LoadEmojis(cfg config.Config) (*emoji.Set, error) {
+	if cfg.View.EmojiSet.Builtin() {
+		return c.Emojier(emoji.WithEmojiSet(EmojiConfigToEmojiProfile(cfg.View.EmojiSet))), nil
+	}
+
+	set, ok := cfg.FindEmojiSet(cfg.View.EmojiSet)
+	if !ok {
+		return nil, fmt.Errorf("%w: %v", ErrEmojiSet, cfg.View.EmojiSet)
+	}
+
+	dir, err := c.Rooter()
+	if err != nil {
+		return nil, fmt.Errorf("unable to find worktree root: %w", err)
+	}
+
+	var ems []emoji.Emoji
+
+	for _, p := range set.ResolvePaths(dir) {
+		es, err := c.readEmojis(p)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load emoji set: %v: %w", set.Name, err)
+		}
+
+		ems = append(ems, es...)
+	}
+
+	prof := emoji.NoProfile
+	if set.Extends != config.EmojiSetUnset {
+		prof = EmojiConfigToEmojiProfile(config.ParseEmojiSet(string(set.Extends)))
+	}
+
+	return c.Emojier(
+		emoji.WithEmojiSet(prof),
+		emoji.WithName(set.Name),
+		emoji.WithFilter(set.Include, set.Exclude),
+		emoji.WithEmojis(ems),
+	), nil
+}
+
+// readEmojis reads the emojis of a file or of every YAML file within a
+// directory, in order of name.
+func (c *Commit) readEmojis(path string) ([]emoji.Emoji, error) {
+	ents, err := c.ReadDirer(path)
+
+	switch {
+	case err == nil:
+	case errors.Is(err, fs.ErrNotExist):
+		return nil, fmt.Errorf("unable to read emojis: %w", err)
+	default:
+		// Not a directory so the path is read as a file.
+		return c.readEmojiFile(path)
+	}
+
+	var ems []emoji.Emoji
+
+	for _, e := range ents {
+		if e.IsDir() || !emojiFileExts[filepath.Ext(e.Name())] {
+			continue
+		}
+
+		es, err := c.readEmojiFile(filepath.Join(path, e.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		ems = append(ems, es...)
+	}
+
+	return ems, nil
+}
+
+func (c *Commit) readEmojiFile(file string) ([]emoji.Emoji, error) {
+	data, err := c.ReadFiler(file)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read emojis: %w", err)
+	}
+
+	ems, err := emoji.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", file, err)
+	}
+
+	return ems, nil
+}
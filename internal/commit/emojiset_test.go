@@ -0,0 +1,167 @@
+package commit_test
+
+import (
+	"io/fs"
+	"testing"
+	"testing/fstest"
+
+	"github.com/mikelorant/committed/internal/commit"
+	"github.com/mikelorant/committed/internal/config"
+	"github.com/mikelorant/committed/internal/emoji"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadEmojis(t *testing.T) {
+	t.Parallel()
+
+	files := fstest.MapFS{
+		"team.yaml": {Data: []byte(heredoc.Doc(`
+			- name: deploy
+			  emoji: 🚀
+			  description: Deploy to production.
+			  shortcode: ":rocket:"
+		`))},
+		"emojis/a.yaml": {Data: []byte(heredoc.Doc(`
+			- name: bug
+			  emoji: 🐞
+			  description: Fix a bug.
+			  shortcode: ":beetle:"
+		`))},
+		"emojis/b.yml": {Data: []byte(heredoc.Doc(`
+			- name: wip
+			  emoji: 🚧
+			  description: Work in progress.
+			  shortcode: ":construction:"
+		`))},
+		"emojis/README.md": {Data: []byte("# Emojis")},
+		"invalid.yaml":     {Data: []byte("invalid")},
+	}
+
+	type want struct {
+		name   string
+		emojis []string
+		len    int
+		err    string
+	}
+
+	tests := []struct {
+		name string
+		cfg  config.Config
+		want want
+	}{
+		{
+			name: "builtin",
+			cfg:  config.Config{View: config.View{EmojiSet: config.EmojiSetDevmoji}},
+			want: want{
+				name: "devmoji",
+				len:  19,
+			},
+		},
+		{
+			name: "custom",
+			cfg: config.Config{
+				View:      config.View{EmojiSet: "team"},
+				EmojiSets: []config.CustomEmojiSet{{Name: "team", Paths: []string{"team.yaml", "emojis"}}},
+			},
+			want: want{
+				name:   "team",
+				emojis: []string{"deploy", "bug", "wip"},
+			},
+		},
+		{
+			name: "extends_filtered",
+			cfg: config.Config{
+				View: config.View{EmojiSet: "team"},
+				EmojiSets: []config.CustomEmojiSet{{
+					Name:    "team",
+					Extends: config.EmojiSetGitmoji,
+					Include: []string{"art", ":bug:", "fire"},
+					Exclude: []string{"fire"},
+					Paths:   []string{"emojis"},
+				}},
+			},
+			want: want{
+				name:   "team",
+				emojis: []string{"art", "bug", "wip"},
+			},
+		},
+		{
+			name: "extends",
+			cfg: config.Config{
+				View:      config.View{EmojiSet: "team"},
+				EmojiSets: []config.CustomEmojiSet{{Name: "team", Extends: config.EmojiSetDevmoji}},
+			},
+			want: want{
+				name: "team",
+				len:  19,
+			},
+		},
+		{
+			name: "unknown",
+			cfg:  config.Config{View: config.View{EmojiSet: "team"}},
+			want: want{
+				err: "unknown emoji set: team",
+			},
+		},
+		{
+			name: "missing",
+			cfg: config.Config{
+				View:      config.View{EmojiSet: "team"},
+				EmojiSets: []config.CustomEmojiSet{{Name: "team", Paths: []string{"missing"}}},
+			},
+			want: want{
+				err: "unable to load emoji set: team: unable to read emojis: open missing: file does not exist",
+			},
+		},
+		{
+			name: "invalid",
+			cfg: config.Config{
+				View:      config.View{EmojiSet: "team"},
+				EmojiSets: []config.CustomEmojiSet{{Name: "team", Paths: []string{"invalid.yaml"}}},
+			},
+			want: want{
+				err: "unable to load emoji set: team: invalid.yaml: unable to decode emojis",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			c := commit.Commit{
+				Emojier:   emoji.New,
+				ReadFiler: files.ReadFile,
+				ReadDirer: func(dir string) ([]fs.DirEntry, error) {
+					return fs.ReadDir(files, dir)
+				},
+				Rooter: MockRoot("", nil),
+			}
+
+			es, err := c.LoadEmojis(tt.cfg)
+			if tt.want.err != "" {
+				assert.ErrorContains(t, err, tt.want.err)
+				return
+			}
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.want.name, es.Name)
+
+			if tt.want.emojis == nil {
+				assert.Len(t, es.Emojis, tt.want.len)
+				return
+			}
+
+			var names []string
+			for _, e := range es.Emojis {
+				names = append(names, e.Name)
+			}
+
+			assert.Equal(t, tt.want.emojis, names)
+		})
+	}
+}
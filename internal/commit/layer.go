@@ -0,0 +1,265 @@
+package commit
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/mikelorant/committed/internal/config"
+	"github.com/mikelorant/committed/internal/keymap"
+	"github.com/mikelorant/committed/internal/theme"
+
+	"github.com/go-git/go-git/v5"
+	gitconfig "github.com/go-git/go-git/v5/config"
+	formatconfig "github.com/go-git/go-git/v5/plumbing/format/config"
+)
+
+// Rooter returns the root directory of the current worktree or an empty
+// string when outside of a repository.
+type Rooter func() (string, error)
+
+// Remoter returns the URLs of the remotes of the repository at a directory.
+type Remoter func(string) ([]string, error)
+
+// GitConfiger returns the git configs that apply to a repository directory
+// in order of precedence. The directory is empty when outside of a
+// repository.
+type GitConfiger func(string) ([]*formatconfig.Config, error)
+
+// Environer returns the environment as "key=value" strings.
+type Environer func() []string
+
+const gitDir = ".git"
+
+// ConfigInputs returns the configuration layers in order of precedence:
+// the global file, git config, the config files of other commit tools, the
+// repository file, environment variables and finally any flag overrides.
+// Defaults are the zero values of the config and are not an input.
+func ConfigInputs(open Opener, root Rooter, gitcfg GitConfiger, environ Environer, file string, overrides []string) ([]config.Input, error) {
+	var ins []config.Input
+
+	dir, err := root()
+	if err != nil {
+		return nil, fmt.Errorf("unable to find worktree root: %w", err)
+	}
+
+	if file != "" {
+		r, err := open(file)
+		if err != nil {
+			return nil, fmt.Errorf("unable to open config file: %v: %w", file, err)
+		}
+
+		ins = append(ins, config.Input{
+			Source: config.Source{Layer: config.LayerGlobal, File: file},
+			Reader: r,
+		})
+	}
+
+	cfgs, err := gitcfg(dir)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load git config: %w", err)
+	}
+
+	kvs, err := config.GitOverrides(cfgs...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse git config: %w", err)
+	}
+
+	in, err := overrideInputs(config.LayerGit, kvs)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse git config: %w", err)
+	}
+
+	ins = append(ins, in...)
+
+	in, err = projectInputs(open, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	ins = append(ins, in...)
+
+	if dir != "" {
+		rfile := filepath.Join(dir, config.RepositoryFile)
+
+		r, err := open(rfile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to open config file: %v: %w", rfile, err)
+		}
+
+		ins = append(ins, config.Input{
+			Source: config.Source{Layer: config.LayerRepository, File: rfile},
+			Reader: r,
+		})
+	}
+
+	in, err = overrideInputs(config.LayerEnvironment, config.EnvironmentOverrides(environ()))
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse environment: %w", err)
+	}
+
+	ins = append(ins, in...)
+
+	in, err = overrideInputs(config.LayerFlag, overrides)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse overrides: %w", err)
+	}
+
+	ins = append(ins, in...)
+
+	return ins, nil
+}
+
+// overrideInputs returns an input for a layer of overrides or none when
+// there are no overrides.
+func overrideInputs(layer config.Layer, kvs []string) ([]config.Input, error) {
+	if len(kvs) == 0 {
+		return nil, nil
+	}
+
+	r, err := config.Overrides(kvs)
+	if err != nil {
+		return nil, err
+	}
+
+	return []config.Input{{
+		Source: config.Source{Layer: layer},
+		Reader: r,
+	}}, nil
+}
+
+// projectInputs returns an input for each config file of another commit
+// tool found in the repository root.
+func projectInputs(open Opener, dir string) ([]config.Input, error) {
+	if dir == "" {
+		return nil, nil
+	}
+
+	var ins []config.Input
+
+	for _, name := range config.ProjectFiles {
+		file := filepath.Join(dir, name)
+
+		r, err := open(file)
+		if err != nil {
+			return nil, fmt.Errorf("unable to open config file: %v: %w", file, err)
+		}
+
+		src, err := io.ReadAll(r)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read config file: %v: %w", file, err)
+		}
+
+		if len(src) == 0 {
+			continue
+		}
+
+		kvs, err := config.ProjectOverrides(file, src)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse project config: %w", err)
+		}
+
+		if len(kvs) == 0 {
+			continue
+		}
+
+		r, err = config.Overrides(kvs)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse project config: %v: %w", file, err)
+		}
+
+		ins = append(ins, config.Input{
+			Source: config.Source{Layer: config.LayerProject, File: file},
+			Reader: r,
+		})
+	}
+
+	return ins, nil
+}
+
+// ConfigOptions returns the validation options for loading a config. Themes
+// are limited to the built-in themes and keys to the actions of the keymap.
+func ConfigOptions(strict bool) config.Options {
+	return config.Options{
+		Strict:  strict,
+		Themes:  theme.IDs(),
+		Actions: keymap.Actions(),
+	}
+}
+
+// RemoteURLs returns the URLs of every remote of the repository at a
+// directory.
+func RemoteURLs(dir string) ([]string, error) {
+	repo, err := git.PlainOpen(dir)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open repository: %w", err)
+	}
+
+	rs, err := repo.Remotes()
+	if err != nil {
+		return nil, fmt.Errorf("unable to list remotes: %w", err)
+	}
+
+	var urls []string
+
+	for _, r := range rs {
+		urls = append(urls, r.Config().URLs...)
+	}
+
+	return urls, nil
+}
+
+// GitConfigs returns the global git config followed by the config of the
+// repository at a directory.
+func GitConfigs(dir string) ([]*formatconfig.Config, error) {
+	global, err := gitconfig.LoadConfig(gitconfig.GlobalScope)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load global config: %w", err)
+	}
+
+	cfgs := []*formatconfig.Config{global.Raw}
+
+	if dir == "" {
+		return cfgs, nil
+	}
+
+	repo, err := git.PlainOpen(dir)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open repository: %w", err)
+	}
+
+	local, err := repo.Config()
+	if err != nil {
+		return nil, fmt.Errorf("unable to load repository config: %w", err)
+	}
+
+	return append(cfgs, local.Raw), nil
+}
+
+// WorktreeRoot searches the working directory and its parents for the
+// root of a Git worktree.
+func WorktreeRoot() (string, error) {
+	dir, err := os.Getwd()
+	if err != nil {
+		return "", fmt.Errorf("unable to get working directory: %w", err)
+	}
+
+	for {
+		_, err := os.Stat(filepath.Join(dir, gitDir))
+		switch {
+		case err == nil:
+			return dir, nil
+		case !errors.Is(err, os.ErrNotExist):
+			return "", fmt.Errorf("unable to stat: %w", err)
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", nil
+		}
+
+		dir = parent
+	}
+}
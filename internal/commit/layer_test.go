@@ -0,0 +1,191 @@
+package commit_test
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/mikelorant/committed/internal/commit"
+	"github.com/mikelorant/committed/internal/config"
+
+	gitconfig "github.com/go-git/go-git/v5/plumbing/format/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigInputs(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		file      string
+		root      string
+		overrides []string
+		git       *gitconfig.Config
+		env       []string
+		files     map[string]string
+		openErr   error
+		rootErr   error
+		gitErr    error
+	}
+
+	type want struct {
+		sources []config.Source
+		err     string
+	}
+
+	tests := []struct {
+		name string
+		args args
+		want want
+	}{
+		{
+			name: "none",
+		},
+		{
+			name: "global",
+			args: args{
+				file: "config.yaml",
+			},
+			want: want{
+				sources: []config.Source{
+					{Layer: config.LayerGlobal, File: "config.yaml"},
+				},
+			},
+		},
+		{
+			name: "all",
+			args: args{
+				file:      "config.yaml",
+				root:      "/repo",
+				overrides: []string{"view.theme=nord"},
+				git:       gitconfig.New().AddOption("committed", "", "emojiSet", "devmoji"),
+				env:       []string{"HOME=/root", "COMMITTED_VIEW_THEME=dracula"},
+			},
+			want: want{
+				sources: []config.Source{
+					{Layer: config.LayerGlobal, File: "config.yaml"},
+					{Layer: config.LayerGit},
+					{Layer: config.LayerRepository, File: "/repo/.committed.yaml"},
+					{Layer: config.LayerEnvironment},
+					{Layer: config.LayerFlag},
+				},
+			},
+		},
+		{
+			name: "project",
+			args: args{
+				root: "/repo",
+				files: map[string]string{
+					"/repo/.gitmojirc.json":    `{"emojiFormat": "emoji"}`,
+					"/repo/.commitlintrc.yaml": "rules: {}",
+					"/repo/.cz.json":           `{"types": ["feat"]}`,
+				},
+			},
+			want: want{
+				sources: []config.Source{
+					{Layer: config.LayerProject, File: "/repo/.gitmojirc.json"},
+					{Layer: config.LayerProject, File: "/repo/.cz.json"},
+					{Layer: config.LayerRepository, File: "/repo/.committed.yaml"},
+				},
+			},
+		},
+		{
+			name: "project_error",
+			args: args{
+				root: "/repo",
+				files: map[string]string{
+					"/repo/.commitlintrc": "rules: {type-enum: 2}",
+				},
+			},
+			want: want{
+				err: "unable to parse project config: invalid project config: .commitlintrc: type-enum: expected a list",
+			},
+		},
+		{
+			name: "open_error",
+			args: args{
+				file:    "config.yaml",
+				openErr: errMock,
+			},
+			want: want{
+				err: "unable to open config file: config.yaml: error",
+			},
+		},
+		{
+			name: "root_error",
+			args: args{
+				rootErr: errMock,
+			},
+			want: want{
+				err: "unable to find worktree root: error",
+			},
+		},
+		{
+			name: "git_error",
+			args: args{
+				gitErr: errMock,
+			},
+			want: want{
+				err: "unable to load git config: error",
+			},
+		},
+		{
+			name: "git_unknown_key",
+			args: args{
+				git: gitconfig.New().AddOption("committed", "", "unknown", "true"),
+			},
+			want: want{
+				err: "unable to parse git config: unknown key: committed.unknown",
+			},
+		},
+		{
+			name: "environment_unknown_key_ignored",
+			args: args{
+				env: []string{"COMMITTED_UNKNOWN=true", "COMMITTED_THEME=nord"},
+			},
+			want: want{
+				sources: []config.Source{
+					{Layer: config.LayerEnvironment},
+				},
+			},
+		},
+		{
+			name: "override_error",
+			args: args{
+				overrides: []string{"invalid"},
+			},
+			want: want{
+				err: "unable to parse overrides: invalid override: invalid",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			open := func(file string) (io.Reader, error) {
+				return strings.NewReader(tt.args.files[file]), tt.args.openErr
+			}
+
+			gitcfg := func(string) ([]*gitconfig.Config, error) {
+				return []*gitconfig.Config{tt.args.git}, tt.args.gitErr
+			}
+
+			ins, err := commit.ConfigInputs(open, MockRoot(tt.args.root, tt.args.rootErr), gitcfg, MockEnviron(tt.args.env...), tt.args.file, tt.args.overrides)
+			if tt.want.err != "" {
+				assert.EqualError(t, err, tt.want.err)
+				return
+			}
+			assert.NoError(t, err)
+
+			var srcs []config.Source
+			for _, in := range ins {
+				srcs = append(srcs, in.Source)
+			}
+
+			assert.Equal(t, tt.want.sources, srcs)
+		})
+	}
+}
@@ -0,0 +1,185 @@
+package commit
+
+import (
+	"bufio"
+	"fmt"
+	"strings"
+
+	"github.com/mikelorant/committed/internal/repository"
+)
+
+// Sources of the commit message as provided to the prepare-commit-msg hook.
+const (
+	SourceMessage  = "message"
+	SourceTemplate = "template"
+	SourceMerge    = "merge"
+	SourceSquash   = "squash"
+	SourceCommit   = "commit"
+)
+
+const (
+	conflictsHeader = "Conflicts:"
+	squashHeader    = "Squashed commit of the following:"
+	squashCommit    = "commit "
+	squashIndent    = "    "
+	combineHeader   = "# This is the "
+	skipHeader      = "# The commit message "
+)
+
+// MessageToConflicts returns the conflicted paths listed in a merge message.
+// Both the commented and uncommented forms written by Git are supported.
+func MessageToConflicts(msg string) []string {
+	var paths []string
+	var inside bool
+
+	scanner := bufio.NewScanner(strings.NewReader(msg))
+
+	for scanner.Scan() {
+		txt := strings.TrimPrefix(scanner.Text(), "#")
+
+		if strings.TrimSpace(txt) == conflictsHeader {
+			inside = true
+			continue
+		}
+
+		if !inside {
+			continue
+		}
+
+		if !strings.HasPrefix(txt, "\t") {
+			inside = false
+			continue
+		}
+
+		if p := strings.TrimSpace(txt); p != "" {
+			paths = append(paths, p)
+		}
+	}
+
+	return paths
+}
+
+// MessageToSquash returns the subjects of the commits being squashed. It
+// understands the message written by "git merge --squash" and the combined
+// message written by an interactive rebase.
+func MessageToSquash(msg string) []string {
+	var subjects []string
+
+	for _, m := range squashMessages(msg) {
+		subjects = append(subjects, strings.SplitN(m, "\n", 2)[0])
+	}
+
+	return subjects
+}
+
+// SquashToBody returns the bodies of the commits being squashed without their
+// trailers, separated by blank lines.
+func SquashToBody(msg string) string {
+	var bodies []string
+
+	for _, m := range squashMessages(msg) {
+		if b := strings.TrimSpace(TrimTrailers(MessageToBody(m))); b != "" {
+			bodies = append(bodies, b)
+		}
+	}
+
+	return strings.Join(bodies, "\n\n")
+}
+
+// SquashToTrailers returns the trailers of the commits being squashed. A
+// trailer repeated by several commits is only returned once.
+func SquashToTrailers(msg string) []repository.Trailer {
+	var trailers []repository.Trailer
+
+	seen := make(map[repository.Trailer]bool)
+
+	for _, m := range squashMessages(msg) {
+		for _, t := range MessageToTrailers(m) {
+			if seen[t] {
+				continue
+			}
+
+			seen[t] = true
+			trailers = append(trailers, t)
+		}
+	}
+
+	return trailers
+}
+
+// CombineSubjects formats subjects as a list suitable for a commit body.
+func CombineSubjects(subjects []string) string {
+	ls := make([]string, len(subjects))
+
+	for i, s := range subjects {
+		ls[i] = fmt.Sprintf("- %s", s)
+	}
+
+	return strings.Join(ls, "\n")
+}
+
+func squashMessages(msg string) []string {
+	if strings.HasPrefix(strings.TrimSpace(msg), squashHeader) {
+		return squashMergeMessages(msg)
+	}
+
+	return squashRebaseMessages(msg)
+}
+
+func squashMergeMessages(msg string) []string {
+	var msgs []string
+	var lines []string
+	var commit, header bool
+
+	scanner := bufio.NewScanner(strings.NewReader(msg))
+
+	for scanner.Scan() {
+		txt := scanner.Text()
+
+		switch {
+		case strings.HasPrefix(txt, squashCommit):
+			msgs = appendMessage(msgs, lines)
+			lines = nil
+			commit, header = true, true
+		case commit && header && strings.TrimSpace(txt) == "":
+			header = false
+		case commit && !header:
+			lines = append(lines, strings.TrimPrefix(txt, squashIndent))
+		}
+	}
+
+	return appendMessage(msgs, lines)
+}
+
+func squashRebaseMessages(msg string) []string {
+	var msgs []string
+	var lines []string
+	var inside bool
+
+	scanner := bufio.NewScanner(strings.NewReader(msg))
+
+	for scanner.Scan() {
+		txt := scanner.Text()
+
+		switch {
+		case strings.HasPrefix(txt, combineHeader), strings.HasPrefix(txt, skipHeader):
+			msgs = appendMessage(msgs, lines)
+			lines = nil
+			inside = strings.HasPrefix(txt, combineHeader)
+		case inside && !strings.HasPrefix(txt, "#"):
+			lines = append(lines, txt)
+		}
+	}
+
+	return appendMessage(msgs, lines)
+}
+
+// appendMessage adds the lines of a squashed commit as a message, ignoring a
+// commit without any message.
+func appendMessage(msgs, lines []string) []string {
+	if m := strings.TrimSpace(strings.Join(lines, "\n")); m != "" {
+		msgs = append(msgs, m)
+	}
+
+	return msgs
+}
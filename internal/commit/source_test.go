@@ -0,0 +1,331 @@
+package commit_test
+
+import (
+	"testing"
+
+	"github.com/mikelorant/committed/internal/commit"
+	"github.com/mikelorant/committed/internal/repository"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMessageToConflicts(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		msg  string
+		want []string
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name: "no_conflicts",
+			msg:  "Merge branch 'test'\n",
+		},
+		{
+			name: "commented",
+			msg: `Merge branch 'test'
+
+# Conflicts:
+#	a.go
+#	b/c.go
+#
+# It looks like you may be committing a merge.
+`,
+			want: []string{"a.go", "b/c.go"},
+		},
+		{
+			name: "uncommented",
+			msg: `Merge branch 'test'
+
+Conflicts:
+	a.go
+	b/c.go
+`,
+			want: []string{"a.go", "b/c.go"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, commit.MessageToConflicts(tt.msg))
+		})
+	}
+}
+
+func TestMessageToSquash(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		msg  string
+		want []string
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name: "merge",
+			msg: `Squashed commit of the following:
+
+commit 0000000000000000000000000000000000000002
+Author: John Doe <john.doe@example.com>
+Date:   Mon Jan 2 15:04:05 2006 -0700
+
+    second
+
+    body
+
+commit 0000000000000000000000000000000000000001
+Author: John Doe <john.doe@example.com>
+Date:   Mon Jan 2 15:04:05 2006 -0700
+
+    first
+`,
+			want: []string{"second", "first"},
+		},
+		{
+			name: "rebase",
+			msg: `# This is a combination of 3 commits.
+# This is the 1st commit message:
+
+first
+
+body
+
+# This is the commit message #2:
+
+second
+
+# The commit message #3 will be skipped:
+
+# third
+`,
+			want: []string{"first", "second"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, commit.MessageToSquash(tt.msg))
+		})
+	}
+}
+
+func TestSquashToBody(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		msg  string
+		want string
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name: "merge",
+			msg: `Squashed commit of the following:
+
+commit 0000000000000000000000000000000000000002
+Author: John Doe <john.doe@example.com>
+Date:   Mon Jan 2 15:04:05 2006 -0700
+
+    second
+
+    second body
+    continued
+
+    Co-authored-by: John Doe <john.doe@example.com>
+
+commit 0000000000000000000000000000000000000001
+Author: John Doe <john.doe@example.com>
+Date:   Mon Jan 2 15:04:05 2006 -0700
+
+    first
+
+    first body
+`,
+			want: "second body\ncontinued\n\nfirst body",
+		},
+		{
+			name: "rebase",
+			msg: `# This is a combination of 3 commits.
+# This is the 1st commit message:
+
+first
+
+first body
+
+Signed-off-by: John Doe <john.doe@example.com>
+
+# This is the commit message #2:
+
+second
+
+# The commit message #3 will be skipped:
+
+# third
+#
+# third body
+`,
+			want: "first body",
+		},
+		{
+			name: "no_body",
+			msg: `# This is a combination of 2 commits.
+# This is the 1st commit message:
+
+first
+
+# This is the commit message #2:
+
+second
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, commit.SquashToBody(tt.msg))
+		})
+	}
+}
+
+func TestSquashToTrailers(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		msg  string
+		want []repository.Trailer
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name: "merge",
+			msg: `Squashed commit of the following:
+
+commit 0000000000000000000000000000000000000002
+Author: John Doe <john.doe@example.com>
+Date:   Mon Jan 2 15:04:05 2006 -0700
+
+    second
+
+    Co-authored-by: John Doe <john.doe@example.com>
+    Signed-off-by: Jane Doe <jane.doe@example.com>
+
+commit 0000000000000000000000000000000000000001
+Author: John Doe <john.doe@example.com>
+Date:   Mon Jan 2 15:04:05 2006 -0700
+
+    first
+
+    body
+
+    Signed-off-by: Jane Doe <jane.doe@example.com>
+`,
+			want: []repository.Trailer{
+				{Key: "Co-authored-by", Value: "John Doe <john.doe@example.com>"},
+				{Key: "Signed-off-by", Value: "Jane Doe <jane.doe@example.com>"},
+			},
+		},
+		{
+			name: "rebase",
+			msg: `# This is a combination of 3 commits.
+# This is the 1st commit message:
+
+first
+
+Refs: #1
+
+# This is the commit message #2:
+
+second
+
+Refs: #2
+
+# The commit message #3 will be skipped:
+
+# third
+#
+# Refs: #3
+`,
+			want: []repository.Trailer{
+				{Key: "Refs", Value: "#1"},
+				{Key: "Refs", Value: "#2"},
+			},
+		},
+		{
+			name: "no_trailers",
+			msg: `# This is a combination of 2 commits.
+# This is the 1st commit message:
+
+first
+
+# This is the commit message #2:
+
+second
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, commit.SquashToTrailers(tt.msg))
+		})
+	}
+}
+
+func TestCombineSubjects(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		subjects []string
+		want     string
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name:     "single",
+			subjects: []string{"first"},
+			want:     "- first",
+		},
+		{
+			name:     "multiple",
+			subjects: []string{"first", "second"},
+			want:     "- first\n- second",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, commit.CombineSubjects(tt.subjects))
+		})
+	}
+}
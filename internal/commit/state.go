@@ -5,6 +5,7 @@ import (
 
 	"github.com/mikelorant/committed/internal/config"
 	"github.com/mikelorant/committed/internal/emoji"
+	"github.com/mikelorant/committed/internal/keymap"
 	"github.com/mikelorant/committed/internal/repository"
 	"github.com/mikelorant/committed/internal/snapshot"
 	"github.com/mikelorant/committed/internal/theme"
@@ -14,18 +15,19 @@ type State struct {
 	Placeholders Placeholders
 	Repository   repository.Description
 	Emojis       *emoji.Set
+	Favourites   []string
 	Theme        theme.Theme
 	Config       config.Config
 	Snapshot     snapshot.Snapshot
 	Options      Options
 	File         File
+	Keymap       keymap.Keymap
 }
 
 type Placeholders struct {
 	Hash    string
 	Summary string
 	Body    string
-	Help    string
 }
 
 type Config struct {
@@ -35,16 +37,16 @@ type Config struct {
 }
 
 type File struct {
-	Amend   bool
-	Message string
+	Amend     bool
+	Message   string
+	Source    string
+	Conflicts []string
+	Squash    []string
 }
 
 //go:embed message.txt
 var PlaceholderMessage string
 
-//go:embed help.txt
-var PlaceholderHelp string
-
 const (
 	PlaceholderHash    string = "1234567890abcdef1234567890abcdef12345678"
 	PlaceholderSummary string = "Capitalized, short (50 chars or less) summary"
@@ -55,6 +57,5 @@ func placeholders() Placeholders {
 		Hash:    PlaceholderHash,
 		Summary: PlaceholderSummary,
 		Body:    PlaceholderMessage,
-		Help:    PlaceholderHelp,
 	}
 }
@@ -0,0 +1,76 @@
+package commit
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/mikelorant/committed/internal/repository"
+)
+
+var TrailerKeys = []string{
+	"Co-authored-by",
+	"Reviewed-by",
+	"Fixes",
+	"Refs",
+	"Change-Id",
+}
+
+var trailerLine = regexp.MustCompile(`^([A-Za-z0-9][A-Za-z0-9-]*): +(.+)$`)
+
+func ParseTrailer(line string) (repository.Trailer, bool) {
+	m := trailerLine.FindStringSubmatch(strings.TrimSpace(line))
+	if m == nil {
+		return repository.Trailer{}, false
+	}
+
+	return repository.Trailer{
+		Key:   m[1],
+		Value: strings.TrimSpace(m[2]),
+	}, true
+}
+
+func MessageToTrailers(msg string) []repository.Trailer {
+	ps := paragraphs(msg)
+	if len(ps) < 2 {
+		return nil
+	}
+
+	return paragraphToTrailers(ps[len(ps)-1])
+}
+
+func TrimTrailers(body string) string {
+	ps := paragraphs(body)
+	if len(ps) == 0 {
+		return body
+	}
+
+	if paragraphToTrailers(ps[len(ps)-1]) == nil {
+		return body
+	}
+
+	return strings.Join(ps[:len(ps)-1], "\n\n")
+}
+
+func paragraphToTrailers(p string) []repository.Trailer {
+	var ts []repository.Trailer
+
+	for _, line := range strings.Split(p, "\n") {
+		t, ok := ParseTrailer(line)
+		if !ok {
+			return nil
+		}
+
+		ts = append(ts, t)
+	}
+
+	return ts
+}
+
+func paragraphs(str string) []string {
+	str = strings.TrimSpace(str)
+	if str == "" {
+		return nil
+	}
+
+	return strings.Split(str, "\n\n")
+}
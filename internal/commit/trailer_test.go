@@ -0,0 +1,161 @@
+package commit_test
+
+import (
+	"testing"
+
+	"github.com/mikelorant/committed/internal/commit"
+	"github.com/mikelorant/committed/internal/repository"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseTrailer(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		line    string
+		trailer repository.Trailer
+		ok      bool
+	}{
+		{
+			name:    "co_author",
+			line:    "Co-authored-by: John Doe <john.doe@example.com>",
+			trailer: repository.Trailer{Key: "Co-authored-by", Value: "John Doe <john.doe@example.com>"},
+			ok:      true,
+		},
+		{
+			name:    "custom",
+			line:    "Acked-by: Jane Doe",
+			trailer: repository.Trailer{Key: "Acked-by", Value: "Jane Doe"},
+			ok:      true,
+		},
+		{
+			name:    "whitespace",
+			line:    "  Fixes:   #123  ",
+			trailer: repository.Trailer{Key: "Fixes", Value: "#123"},
+			ok:      true,
+		},
+		{
+			name: "no_separator",
+			line: "Fixes #123",
+		},
+		{
+			name: "no_value",
+			line: "Fixes: ",
+		},
+		{
+			name: "space_in_key",
+			line: "Fixed bug: crash",
+		},
+		{
+			name: "url",
+			line: "https://example.com",
+		},
+		{
+			name: "empty",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			trailer, ok := commit.ParseTrailer(tt.line)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.trailer, trailer)
+		})
+	}
+}
+
+func TestMessageToTrailers(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		message  string
+		trailers []repository.Trailer
+	}{
+		{
+			name:    "summary_body_trailers",
+			message: "summary\n\nbody\n\nFixes: #123\nSigned-off-by: John Doe <john.doe@example.com>\n",
+			trailers: []repository.Trailer{
+				{Key: "Fixes", Value: "#123"},
+				{Key: "Signed-off-by", Value: "John Doe <john.doe@example.com>"},
+			},
+		},
+		{
+			name:    "summary_trailers",
+			message: "summary\n\nRefs: #1",
+			trailers: []repository.Trailer{
+				{Key: "Refs", Value: "#1"},
+			},
+		},
+		{
+			name:    "mixed_paragraph",
+			message: "summary\n\nbody\n\nFixes: #123\nnot a trailer",
+		},
+		{
+			name:    "summary_only",
+			message: "Fixes: #123",
+		},
+		{
+			name:    "body_only",
+			message: "summary\n\nbody",
+		},
+		{
+			name: "empty",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.trailers, commit.MessageToTrailers(tt.message))
+		})
+	}
+}
+
+func TestTrimTrailers(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		body string
+		want string
+	}{
+		{
+			name: "body_trailers",
+			body: "body\n\nmore body\n\nFixes: #123\nRefs: #1",
+			want: "body\n\nmore body",
+		},
+		{
+			name: "trailers",
+			body: "Fixes: #123",
+			want: "",
+		},
+		{
+			name: "body",
+			body: "body\n\nmore body",
+			want: "body\n\nmore body",
+		},
+		{
+			name: "empty",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, commit.TrimTrailers(tt.body))
+		})
+	}
+}
@@ -10,14 +10,22 @@ import (
 	"github.com/mikelorant/committed/internal/repository"
 )
 
-func MessageToEmoji(set *emoji.Set, msg string) emoji.NullEmoji {
-	ls := strings.Split(msg, "\n")
-	fw := strings.Split(ls[0], " ")[0]
+// MessageToEmoji returns the emoji starting a message. With the conventional
+// style the emoji may also follow the prefix.
+func MessageToEmoji(set *emoji.Set, msg string, cfg config.Commit) emoji.NullEmoji {
+	line := strings.Split(msg, "\n")[0]
+
+	if e := set.Find(strings.Split(line, " ")[0]); e.Valid || cfg.Style != config.StyleConventional {
+		return e
+	}
 
-	return set.Find(fw)
+	return set.Find(strings.Split(TrimConventional(line, Types(cfg)), " ")[0])
 }
 
-func MessageToSummary(msg string) string {
+// MessageToSummary returns the summary of a message. The conventional prefix
+// is only removed with the conventional style, as it is otherwise part of the
+// summary.
+func MessageToSummary(msg string, cfg config.Commit) string {
 	lines := strings.Split(msg, "\n")
 	line := lines[0]
 
@@ -35,7 +43,11 @@ func MessageToSummary(msg string) string {
 		line = strings.Join(ls[1:], " ")
 	}
 
-	return line
+	if cfg.Style != config.StyleConventional {
+		return line
+	}
+
+	return TrimEmoji(TrimConventional(line, Types(cfg)))
 }
 
 func MessageToBody(msg string) string {
@@ -55,18 +67,35 @@ func MessageToBody(msg string) string {
 	return strings.Join(ls[2:], "\n")
 }
 
-func EmojiSummaryToSubject(emoji, summary string) string {
-	var subject string
+// EmojiSummaryToSubject joins the parts of a subject. The emoji follows any
+// conventional prefix so that the subject remains a valid conventional
+// commit header.
+func EmojiSummaryToSubject(emoji, summary string, conv Conventional) string {
+	subject := summary
 
 	if emoji != "" {
-		subject = fmt.Sprintf("%s %s", emoji, summary)
-	} else {
-		subject = summary
+		subject = fmt.Sprintf("%s %s", emoji, subject)
+	}
+
+	if pfx := ConventionalToPrefix(conv); pfx != "" {
+		subject = fmt.Sprintf("%s %s", pfx, subject)
 	}
 
 	return subject
 }
 
+func ComposeMessage(subject, body string, trailers []repository.Trailer) string {
+	var ps []string
+
+	for _, p := range []string{subject, body, repository.TrailersToString(trailers)} {
+		if p != "" {
+			ps = append(ps, p)
+		}
+	}
+
+	return strings.Join(ps, "\n\n")
+}
+
 func UserToAuthor(user repository.User) string {
 	if user.Name == "" || user.Email == "" {
 		return ""
@@ -76,7 +105,13 @@ func UserToAuthor(user repository.User) string {
 }
 
 func EmojiConfigToEmojiProfile(e config.EmojiSet) emoji.Profile {
-	return emoji.Profile(int(e))
+	profile := map[config.EmojiSet]emoji.Profile{
+		config.EmojiSetGitmoji:  emoji.GitmojiProfile,
+		config.EmojiSetDevmoji:  emoji.DevmojiProfile,
+		config.EmojiSetEmojiLog: emoji.EmojiLogProfile,
+	}
+
+	return profile[e]
 }
 
 func SortUsersByDefault(us ...repository.User) []repository.User {
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/mikelorant/committed/internal/commit"
+	"github.com/mikelorant/committed/internal/config"
 	"github.com/mikelorant/committed/internal/emoji"
 	"github.com/mikelorant/committed/internal/repository"
 
@@ -24,6 +25,7 @@ func TestMessageToEmoji(t *testing.T) {
 	tests := []struct {
 		name    string
 		message string
+		style   config.Style
 		want    want
 	}{
 		{
@@ -58,6 +60,19 @@ func TestMessageToEmoji(t *testing.T) {
 				name:  "art",
 			},
 		},
+		{
+			name:    "conventional_emoji_summary",
+			message: "feat(ui)!: :art: summary",
+			style:   config.StyleConventional,
+			want: want{
+				valid: true,
+				name:  "art",
+			},
+		},
+		{
+			name:    "conventional_emoji_summary_emoji_style",
+			message: "feat(ui)!: :art: summary",
+		},
 		{
 			name:    "unknown_emoji",
 			message: "😀 summary",
@@ -78,7 +93,7 @@ func TestMessageToEmoji(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			e := commit.MessageToEmoji(emoji.New(), tt.message)
+			e := commit.MessageToEmoji(emoji.New(), tt.message, config.Commit{Style: tt.style})
 			if !tt.want.valid {
 				assert.False(t, tt.want.valid)
 				assert.Empty(t, e.Emoji.Name)
@@ -96,6 +111,8 @@ func TestMessageToSummary(t *testing.T) {
 	tests := []struct {
 		name    string
 		message string
+		style   config.Style
+		types   []string
 		summary string
 	}{
 		{
@@ -121,6 +138,60 @@ func TestMessageToSummary(t *testing.T) {
 			name:    "long_summary",
 			message: "Lorem ipsum dolor sit amet, consectetur adipiscing elit, sed do eiusmod tempor",
 		},
+		{
+			name:    "conventional_summary",
+			message: "feat: summary",
+			style:   config.StyleConventional,
+			summary: "summary",
+		},
+		{
+			name:    "emoji_conventional_scope_breaking_summary",
+			message: ":art: fix(ui)!: summary",
+			style:   config.StyleConventional,
+			summary: "summary",
+		},
+		{
+			name:    "conventional_scope_breaking_emoji_summary",
+			message: "fix(ui)!: :art: summary",
+			style:   config.StyleConventional,
+			summary: "summary",
+		},
+		{
+			name:    "unknown_type_summary",
+			message: "update: summary",
+			style:   config.StyleConventional,
+			summary: "update: summary",
+		},
+		{
+			name:    "configured_type_summary",
+			message: "task: summary",
+			style:   config.StyleConventional,
+			types:   []string{"task"},
+			summary: "summary",
+		},
+		{
+			name:    "conventional_summary_emoji_style",
+			message: "feat: summary",
+			style:   config.StyleEmoji,
+			summary: "feat: summary",
+		},
+		{
+			name:    "emoji_conventional_summary_emoji_style",
+			message: ":art: fix(ui)!: summary",
+			style:   config.StyleEmoji,
+			summary: "fix(ui)!: summary",
+		},
+		{
+			name:    "conventional_summary_unset_style",
+			message: "feat: summary",
+			summary: "feat: summary",
+		},
+		{
+			name:    "capitalised_colon_summary",
+			message: "Note: summary",
+			style:   config.StyleConventional,
+			summary: "Note: summary",
+		},
 		{
 			name:    "multiline",
 			message: "summary\n\nbody",
@@ -146,7 +217,7 @@ func TestMessageToSummary(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			s := commit.MessageToSummary(tt.message)
+			s := commit.MessageToSummary(tt.message, config.Commit{Style: tt.style, Types: tt.types})
 			if tt.summary == "" {
 				assert.Empty(t, s)
 				return
@@ -216,8 +287,9 @@ func TestEmojiSummaryToSubject(t *testing.T) {
 	t.Parallel()
 
 	type args struct {
-		emoji   string
-		summary string
+		emoji        string
+		summary      string
+		conventional commit.Conventional
 	}
 
 	tests := []struct {
@@ -225,6 +297,23 @@ func TestEmojiSummaryToSubject(t *testing.T) {
 		args    args
 		subject string
 	}{
+		{
+			name: "conventional_summary",
+			args: args{
+				summary:      "summary",
+				conventional: commit.Conventional{Type: "feat"},
+			},
+			subject: "feat: summary",
+		},
+		{
+			name: "conventional_scope_breaking_emoji_summary",
+			args: args{
+				emoji:        ":art:",
+				summary:      "summary",
+				conventional: commit.Conventional{Type: "fix", Scope: "ui", Breaking: true},
+			},
+			subject: "fix(ui)!: :art: summary",
+		},
 		{
 			name: "emoji_summary",
 			args: args{
@@ -251,7 +340,7 @@ func TestEmojiSummaryToSubject(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			s := commit.EmojiSummaryToSubject(tt.args.emoji, tt.args.summary)
+			s := commit.EmojiSummaryToSubject(tt.args.emoji, tt.args.summary, tt.args.conventional)
 			if tt.subject == "" {
 				assert.Empty(t, s)
 				return
@@ -262,6 +351,72 @@ func TestEmojiSummaryToSubject(t *testing.T) {
 	}
 }
 
+func TestComposeMessage(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		subject  string
+		body     string
+		trailers []repository.Trailer
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		message string
+	}{
+		{
+			name: "subject",
+			args: args{
+				subject: "summary",
+			},
+			message: "summary",
+		},
+		{
+			name: "subject_body",
+			args: args{
+				subject: "summary",
+				body:    "body",
+			},
+			message: "summary\n\nbody",
+		},
+		{
+			name: "subject_trailers",
+			args: args{
+				subject:  "summary",
+				trailers: []repository.Trailer{{Key: "Refs", Value: "#1"}},
+			},
+			message: "summary\n\nRefs: #1",
+		},
+		{
+			name: "subject_body_trailers",
+			args: args{
+				subject: "summary",
+				body:    "body",
+				trailers: []repository.Trailer{
+					{Key: "Refs", Value: "#1"},
+					{Key: "Fixes", Value: "#2"},
+				},
+			},
+			message: "summary\n\nbody\n\nRefs: #1\nFixes: #2",
+		},
+		{
+			name: "empty",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			msg := commit.ComposeMessage(tt.args.subject, tt.args.body, tt.args.trailers)
+			assert.Equal(t, tt.message, msg)
+		})
+	}
+}
+
 func TestUserToAuthor(t *testing.T) {
 	t.Parallel()
 
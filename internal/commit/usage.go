@@ -0,0 +1,98 @@
+package commit
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/mikelorant/committed/internal/emoji"
+	"github.com/mikelorant/committed/internal/usage"
+)
+
+// Usager loads and saves the emojis used and pinned in each repository.
+type Usager interface {
+	Load(io.Reader) (usage.Usage, error)
+	Save(io.WriteCloser, usage.Usage) error
+}
+
+// Nower returns the current time.
+type Nower func() time.Time
+
+// rankEmojis orders emojis by their usage in the current repository and
+// returns the pinned favourites. Usage is only tracked within a worktree.
+func (c *Commit) rankEmojis(opts Options, emojis []emoji.Emoji) ([]emoji.Emoji, []string, error) {
+	dir, err := c.Rooter()
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to find worktree root: %w", err)
+	}
+
+	if dir == "" || opts.UsageFile == "" {
+		return emojis, nil, nil
+	}
+
+	r, err := c.Opener(opts.UsageFile)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to open usage: %v: %w", opts.UsageFile, err)
+	}
+
+	u, err := c.Usager.Load(r)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to load usage: %w", err)
+	}
+
+	c.usage = u
+	c.root = dir
+
+	rec := u.Record(dir)
+
+	return rec.Rank(emojis, c.Nower()), rec.Favourites, nil
+}
+
+// saveUsage records the emoji of an applied commit and any change to the
+// favourites. Nothing is written when the usage is unchanged.
+func (c *Commit) saveUsage(req *Request, applied bool) error {
+	if c.root == "" {
+		return nil
+	}
+
+	rec := c.usage.Record(c.root)
+	changed := !equal(rec.Favourites, req.Favourites)
+
+	rec.Favourites = req.Favourites
+
+	if applied && !c.Options.DryRun && req.Shortcode != "" {
+		rec.Use(req.Shortcode, c.Nower())
+		changed = true
+	}
+
+	if !changed {
+		return nil
+	}
+
+	c.usage.SetRecord(c.root, rec)
+
+	w, err := c.Creator(c.Options.UsageFile)
+	if err != nil {
+		return fmt.Errorf("unable to create usage: %w", err)
+	}
+
+	if err := c.Usager.Save(w, c.usage); err != nil {
+		return fmt.Errorf("unable to save usage: %w", err)
+	}
+
+	return nil
+}
+
+func equal(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,267 @@
+package commit_test
+
+import (
+	"io"
+	"testing"
+	"time"
+
+	"github.com/mikelorant/committed/internal/commit"
+	"github.com/mikelorant/committed/internal/emoji"
+	"github.com/mikelorant/committed/internal/usage"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type MockUsage struct {
+	usage   usage.Usage
+	saved   *usage.Usage
+	loadErr error
+	saveErr error
+}
+
+func (u *MockUsage) Load(fh io.Reader) (usage.Usage, error) {
+	return u.usage, u.loadErr
+}
+
+func (u *MockUsage) Save(w io.WriteCloser, us usage.Usage) error {
+	if u.saveErr != nil {
+		return u.saveErr
+	}
+
+	u.saved = &us
+
+	return nil
+}
+
+var usageNow = time.Date(2022, time.January, 31, 12, 0, 0, 0, time.UTC)
+
+func MockNow() time.Time {
+	return usageNow
+}
+
+func TestUsage(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		root      string
+		usageFile string
+		dryRun    bool
+		req       *commit.Request
+		applyErr  error
+		loadErr   error
+		saveErr   error
+	}
+
+	type want struct {
+		emojis     []string
+		favourites []string
+		saved      *usage.Usage
+		err        string
+	}
+
+	record := func() usage.Record {
+		return usage.Record{
+			Favourites: []string{":bug:"},
+			Emojis: map[string]usage.Entry{
+				":zap:": {Count: 2, Last: usageNow.Add(-time.Hour)},
+			},
+		}
+	}
+
+	tests := []struct {
+		name string
+		args args
+		want want
+	}{
+		{
+			name: "outside_worktree",
+			args: args{
+				usageFile: "usage.yaml",
+				req:       &commit.Request{Apply: true, Shortcode: ":art:"},
+			},
+			want: want{
+				emojis: []string{":art:", ":zap:", ":bug:"},
+			},
+		},
+		{
+			name: "ranked",
+			args: args{
+				root:      "/repo",
+				usageFile: "usage.yaml",
+				req:       &commit.Request{Favourites: []string{":bug:"}},
+			},
+			want: want{
+				emojis:     []string{":zap:", ":art:", ":bug:"},
+				favourites: []string{":bug:"},
+			},
+		},
+		{
+			name: "applied",
+			args: args{
+				root:      "/repo",
+				usageFile: "usage.yaml",
+				req:       &commit.Request{Apply: true, Shortcode: ":zap:", Favourites: []string{":bug:"}},
+			},
+			want: want{
+				emojis:     []string{":zap:", ":art:", ":bug:"},
+				favourites: []string{":bug:"},
+				saved: &usage.Usage{
+					Repositories: map[string]usage.Record{
+						"/repo": {
+							Favourites: []string{":bug:"},
+							Emojis: map[string]usage.Entry{
+								":zap:": {Count: 3, Last: usageNow},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "dry_run",
+			args: args{
+				root:      "/repo",
+				usageFile: "usage.yaml",
+				dryRun:    true,
+				req:       &commit.Request{Apply: true, Shortcode: ":zap:", Favourites: []string{":bug:"}},
+			},
+			want: want{
+				emojis:     []string{":zap:", ":art:", ":bug:"},
+				favourites: []string{":bug:"},
+			},
+		},
+		{
+			name: "apply_error",
+			args: args{
+				root:      "/repo",
+				usageFile: "usage.yaml",
+				req:       &commit.Request{Apply: true, Shortcode: ":zap:", Favourites: []string{":bug:"}},
+				applyErr:  errMockExit,
+			},
+			want: want{
+				emojis:     []string{":zap:", ":art:", ":bug:"},
+				favourites: []string{":bug:"},
+			},
+		},
+		{
+			name: "pinned",
+			args: args{
+				root:      "/repo",
+				usageFile: "usage.yaml",
+				req:       &commit.Request{Favourites: []string{":bug:", ":art:"}},
+			},
+			want: want{
+				emojis:     []string{":zap:", ":art:", ":bug:"},
+				favourites: []string{":bug:"},
+				saved: &usage.Usage{
+					Repositories: map[string]usage.Record{
+						"/repo": {
+							Favourites: []string{":bug:", ":art:"},
+							Emojis:     record().Emojis,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "no_usage_file",
+			args: args{
+				root: "/repo",
+				req:  &commit.Request{Apply: true, Shortcode: ":zap:"},
+			},
+			want: want{
+				emojis: []string{":art:", ":zap:", ":bug:"},
+			},
+		},
+		{
+			name: "load_error",
+			args: args{
+				root:      "/repo",
+				usageFile: "usage.yaml",
+				loadErr:   errMock,
+			},
+			want: want{
+				err: "unable to rank emojis: unable to load usage: error",
+			},
+		},
+		{
+			name: "save_error",
+			args: args{
+				root:      "/repo",
+				usageFile: "usage.yaml",
+				req:       &commit.Request{Apply: true, Shortcode: ":zap:"},
+				saveErr:   errMock,
+			},
+			want: want{
+				emojis:     []string{":zap:", ":art:", ":bug:"},
+				favourites: []string{":bug:"},
+				err:        "unable to save usage: error",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			u := MockUsage{
+				usage: usage.Usage{
+					Repositories: map[string]usage.Record{"/repo": record()},
+				},
+				loadErr: tt.args.loadErr,
+				saveErr: tt.args.saveErr,
+			}
+
+			c := commit.Commit{
+				Repoer:      &MockRepository{applyErr: tt.args.applyErr},
+				Snapshotter: &MockSnapshot{},
+				Configer:    &MockConfig{},
+				Emojier: func(...func(*emoji.Set)) *emoji.Set {
+					return &emoji.Set{Emojis: []emoji.Emoji{
+						{Shortcode: ":art:"},
+						{Shortcode: ":zap:"},
+						{Shortcode: ":bug:"},
+					}}
+				},
+				Creator:     MockCreate(nil),
+				Opener:      MockOpen(nil),
+				ReadFiler:   MockReadFile("", nil),
+				Rooter:      MockRoot(tt.args.root, nil),
+				Remoter:     func(string) ([]string, error) { return nil, nil },
+				GitConfiger: MockGitConfig(),
+				Environer:   MockEnviron(),
+				Usager:      &u,
+				Nower:       MockNow,
+			}
+
+			state, err := c.Configure(commit.Options{
+				UsageFile: tt.args.usageFile,
+				DryRun:    tt.args.dryRun,
+			})
+			if tt.want.err != "" && tt.args.req == nil {
+				assert.EqualError(t, err, tt.want.err)
+				return
+			}
+			assert.NoError(t, err)
+
+			var shortcodes []string
+			for _, e := range state.Emojis.Emojis {
+				shortcodes = append(shortcodes, e.Shortcode)
+			}
+
+			assert.Equal(t, tt.want.emojis, shortcodes)
+			assert.Equal(t, tt.want.favourites, state.Favourites)
+
+			err = c.Apply(tt.args.req)
+			if tt.want.err != "" {
+				assert.EqualError(t, err, tt.want.err)
+				return
+			}
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.want.saved, u.saved)
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package config
+
+import (
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+type Style int
+
+const (
+	StyleUnset Style = iota
+	StyleEmoji
+	StyleConventional
+)
+
+func (s *Style) UnmarshalYAML(value *yaml.Node) error {
+	*s = ParseStyle(value.Value)
+
+	return nil
+}
+
+func (s Style) MarshalYAML() (interface{}, error) {
+	return []string{
+		"",
+		"emoji",
+		"conventional",
+	}[s], nil
+}
+
+func ParseStyle(str string) Style {
+	style := map[string]Style{
+		"":             StyleUnset,
+		"emoji":        StyleEmoji,
+		"conventional": StyleConventional,
+	}
+
+	return style[strings.ToLower(str)]
+}
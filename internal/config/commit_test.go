@@ -0,0 +1,64 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/mikelorant/committed/internal/config"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+)
+
+func TestUnmarshallYAMLStyle(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		want  config.Style
+	}{
+		{name: "empty", input: "", want: config.StyleUnset},
+		{name: "emoji", input: "emoji", want: config.StyleEmoji},
+		{name: "conventional", input: "conventional", want: config.StyleConventional},
+		{name: "invalid", input: "invalid", want: config.StyleUnset},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var got config.Style
+
+			yaml.Unmarshal([]byte(tt.input), &got)
+			assert.Equal(t, tt.want, got, tt.name)
+		})
+	}
+}
+
+func TestMarshallYAMLStyle(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input config.Style
+		want  string
+	}{
+		{name: "empty", input: config.StyleUnset, want: "\"\"\n"},
+		{name: "emoji", input: config.StyleEmoji, want: "emoji\n"},
+		{name: "conventional", input: config.StyleConventional, want: "conventional\n"},
+		{name: "invalid", input: config.StyleUnset, want: "\"\"\n"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, _ := yaml.Marshal(&tt.input)
+			assert.Equal(t, tt.want, string(got), tt.name)
+		})
+	}
+}
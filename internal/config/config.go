@@ -1,7 +1,6 @@
 package config
 
 import (
-	"errors"
 	"fmt"
 	"io"
 
@@ -11,9 +10,18 @@ import (
 )
 
 type Config struct {
-	View    View              `yaml:"view,omitempty,flow"`
-	Commit  Commit            `yaml:"commit,omitempty,flow"`
-	Authors []repository.User `yaml:"authors,omitempty,flow"`
+	Version   int                 `yaml:"version,omitempty"`
+	View      View                `yaml:"view,omitempty,flow"`
+	Commit    Commit              `yaml:"commit,omitempty,flow"`
+	Lint      Lint                `yaml:"lint,omitempty,flow"`
+	Authors   []repository.User   `yaml:"authors,omitempty,flow"`
+	EmojiSets []CustomEmojiSet    `yaml:"emojiSets,omitempty"`
+	Themes    []CustomTheme       `yaml:"themes,omitempty"`
+	Keys      map[string][]string `yaml:"keys,omitempty"`
+	Profiles  []Profile           `yaml:"profiles,omitempty"`
+
+	// Profile is the name of the selected profile.
+	Profile string `yaml:"-"`
 }
 
 type View struct {
@@ -23,6 +31,7 @@ type View struct {
 	Compatibility      Compatibility `yaml:"compatibility,omitempty,flow"`
 	Theme              string        `yaml:"theme,omitempty,flow"`
 	Colour             Colour        `yaml:"colour,omitempty,flow"`
+	EditMode           EditMode      `yaml:"editMode,omitempty,flow"`
 	HighlightActive    bool          `yaml:"highlightActive,omitempty,flow"`
 	IgnoreGlobalAuthor bool          `yaml:"ignoreGlobalAuthor,omitempty,flow"`
 }
@@ -30,16 +39,34 @@ type View struct {
 type Commit struct {
 	EmojiType EmojiType `yaml:"emojiType,omitempty"`
 	Signoff   bool      `yaml:"signoff,omitempty"`
+	Style     Style     `yaml:"style,omitempty"`
+	Types     []string  `yaml:"types,omitempty,flow"`
+	Scopes    []string  `yaml:"scopes,omitempty,flow"`
 }
 
-func (c *Config) Load(fh io.Reader) (Config, error) {
+// Load decodes a config. Invalid values, and unknown keys when strict, are
+// returned as a ValidationError.
+func (c *Config) Load(fh io.Reader, opts Options) (Config, error) {
 	var cfg Config
 
-	err := yaml.NewDecoder(fh).Decode(&cfg)
-	switch {
-	case err == nil:
-	case errors.Is(err, io.EOF):
-	default:
+	n, err := decodeNode(fh)
+	if err != nil {
+		return cfg, fmt.Errorf("unable to decode config: %w", err)
+	}
+
+	if n == nil {
+		return cfg, nil
+	}
+
+	if _, err := migrateNode(n); err != nil {
+		return cfg, fmt.Errorf("unable to migrate config: %w", err)
+	}
+
+	if ps := (validator{opts: opts}).node(n, ""); len(ps) > 0 {
+		return cfg, &ValidationError{Problems: ps}
+	}
+
+	if err := n.Decode(&cfg); err != nil {
 		return cfg, fmt.Errorf("unable to decode config: %w", err)
 	}
 
@@ -12,7 +12,6 @@ import (
 
 	"github.com/MakeNowJust/heredoc/v2"
 	"github.com/stretchr/testify/assert"
-	"gopkg.in/yaml.v3"
 )
 
 type badBuffer struct {
@@ -44,8 +43,9 @@ func TestLoad(t *testing.T) {
 	tests := []struct {
 		name   string
 		data   string
+		opts   config.Options
 		config config.Config
-		err    error
+		err    string
 	}{
 		{
 			name:   "empty",
@@ -54,7 +54,7 @@ func TestLoad(t *testing.T) {
 		{
 			name: "invalid",
 			data: "invalid",
-			err:  new(yaml.TypeError),
+			err:  "unable to decode config: config must be a mapping",
 		},
 		{
 			name:   "focus_empty",
@@ -77,9 +77,9 @@ func TestLoad(t *testing.T) {
 			config: config.Config{View: config.View{Focus: config.FocusSummary}},
 		},
 		{
-			name:   "focus_invalid",
-			data:   "view: {focus: invalid}",
-			config: config.Config{View: config.View{Focus: config.FocusUnset}},
+			name: "focus_invalid",
+			data: "view: {focus: invalid}",
+			err:  "invalid config: 1:15: view.focus: invalid value, allowed values: author, emoji, summary: \"invalid\"",
 		},
 		{
 			name:   "highlight_empty",
@@ -99,7 +99,7 @@ func TestLoad(t *testing.T) {
 		{
 			name: "highlight_invalid",
 			data: "view: {highlightActive: invalid}",
-			err:  new(yaml.TypeError),
+			err:  "invalid config: 1:25: view.highlightActive: invalid value, expected a boolean: \"invalid\"",
 		},
 		{
 			name:   "ignoreglobalauthor_empty",
@@ -119,7 +119,7 @@ func TestLoad(t *testing.T) {
 		{
 			name: "ignoreglobalauthor_invalid",
 			data: "view: {ignoreGlobalAuthor: invalid}",
-			err:  new(yaml.TypeError),
+			err:  "invalid config: 1:28: view.ignoreGlobalAuthor: invalid value, expected a boolean: \"invalid\"",
 		},
 		{
 			name:   "compatibility_empty",
@@ -142,9 +142,19 @@ func TestLoad(t *testing.T) {
 			config: config.Config{View: config.View{Compatibility: config.CompatibilityKitty}},
 		},
 		{
-			name:   "compatibility_invalid",
-			data:   "view: {compatibility: invalid}",
-			config: config.Config{View: config.View{Compatibility: config.CompatibilityUnset}},
+			name:   "editmode_vim",
+			data:   "view: {editMode: vim}",
+			config: config.Config{View: config.View{EditMode: config.EditModeVim}},
+		},
+		{
+			name: "editmode_invalid",
+			data: "view: {editMode: emacs}",
+			err:  "invalid config: 1:18: view.editMode: invalid value, allowed values: default, vim: \"emacs\"",
+		},
+		{
+			name: "compatibility_invalid",
+			data: "view: {compatibility: invalid}",
+			err:  "invalid config: 1:23: view.compatibility: invalid value, allowed values: default, ttyd, kitty: \"invalid\"",
 		},
 		{
 			name:   "colour_unset",
@@ -167,9 +177,9 @@ func TestLoad(t *testing.T) {
 			config: config.Config{View: config.View{Colour: config.ColourLight}},
 		},
 		{
-			name:   "colour_invalid",
-			data:   "view: {colour: invalid}",
-			config: config.Config{View: config.View{Colour: config.ColourUnset}},
+			name: "colour_invalid",
+			data: "view: {colour: invalid}",
+			err:  "invalid config: 1:16: view.colour: invalid value, allowed values: adaptive, dark, light: \"invalid\"",
 		},
 		{
 			name:   "emojiset_empty",
@@ -197,9 +207,32 @@ func TestLoad(t *testing.T) {
 			config: config.Config{View: config.View{EmojiSet: config.EmojiSetEmojiLog}},
 		},
 		{
-			name:   "emojiset_invalid",
-			data:   "view: {emojiSet: invalid}",
-			config: config.Config{View: config.View{EmojiSet: config.EmojiSetUnset}},
+			name: "emojiset_invalid",
+			data: "view: {emojiSet: invalid}",
+			err:  "invalid config: 1:18: view.emojiSet: invalid value, allowed values: gitmoji, devmoji, emojilog: \"invalid\"",
+		},
+		{
+			name:   "theme_known",
+			data:   "view: {theme: nord}",
+			opts:   config.Options{Themes: []string{"builtin_dark", "nord"}},
+			config: config.Config{View: config.View{Theme: "nord"}},
+		},
+		{
+			name: "theme_unknown",
+			data: "view: {theme: nords}",
+			opts: config.Options{Themes: []string{"builtin_dark", "nord"}},
+			err:  "invalid config: 1:15: view.theme: invalid value, allowed values: builtin_dark, nord: \"nords\"",
+		},
+		{
+			name:   "unknown_key",
+			data:   "view: {emojiSets: devmoji}",
+			config: config.Config{},
+		},
+		{
+			name: "unknown_key_strict",
+			data: "view: {emojiSets: devmoji}",
+			opts: config.Options{Strict: true},
+			err:  "invalid config: 1:8: view.emojiSets: unknown key",
 		},
 		{
 			name:   "emojiselector_empty",
@@ -217,9 +250,9 @@ func TestLoad(t *testing.T) {
 			config: config.Config{View: config.View{EmojiSelector: config.EmojiSelectorAbove}},
 		},
 		{
-			name:   "emojiselector_invalid",
-			data:   "view: {emojiSelector: invalid}",
-			config: config.Config{View: config.View{EmojiSelector: config.EmojiSelectorUnset}},
+			name: "emojiselector_invalid",
+			data: "view: {emojiSelector: invalid}",
+			err:  "invalid config: 1:23: view.emojiSelector: invalid value, allowed values: below, above: \"invalid\"",
 		},
 		{
 			name:   "emojitype_empty",
@@ -237,9 +270,9 @@ func TestLoad(t *testing.T) {
 			config: config.Config{Commit: config.Commit{EmojiType: config.EmojiTypeCharacter}},
 		},
 		{
-			name:   "emojitype_invalid",
-			data:   "commit: {emojiType: invalid}",
-			config: config.Config{Commit: config.Commit{EmojiType: config.EmojiTypeUnset}},
+			name: "emojitype_invalid",
+			data: "commit: {emojiType: invalid}",
+			err:  "invalid config: 1:21: commit.emojiType: invalid value, allowed values: shortcode, character: \"invalid\"",
 		},
 		{
 			name:   "signoff_empty",
@@ -257,10 +290,49 @@ func TestLoad(t *testing.T) {
 			config: config.Config{Commit: config.Commit{Signoff: true}},
 		},
 		{
-			name:   "signoff_invalid",
-			data:   "commit: {signoff: invalid}",
-			config: config.Config{Commit: config.Commit{Signoff: false}},
-			err:    new(yaml.TypeError),
+			name:   "style_conventional",
+			data:   "commit: {style: conventional}",
+			config: config.Config{Commit: config.Commit{Style: config.StyleConventional}},
+		},
+		{
+			name: "style_invalid",
+			data: "commit: {style: invalid}",
+			err:  "invalid config: 1:17: commit.style: invalid value, allowed values: emoji, conventional: \"invalid\"",
+		},
+		{
+			name:   "types",
+			data:   "commit: {types: [feat, fix]}",
+			config: config.Config{Commit: config.Commit{Types: []string{"feat", "fix"}}},
+		},
+		{
+			name:   "scopes",
+			data:   "commit: {scopes: [api, ui]}",
+			config: config.Config{Commit: config.Commit{Scopes: []string{"api", "ui"}}},
+		},
+		{
+			name:   "lint_severity",
+			data:   "lint: {summaryLength: error, bodyWidth: off}",
+			config: config.Config{Lint: config.Lint{SummaryLength: config.SeverityError, BodyWidth: config.SeverityOff}},
+		},
+		{
+			name: "lint_severity_invalid",
+			data: "lint: {summaryLength: invalid}",
+			err:  "invalid config: 1:23: lint.summaryLength: invalid value, allowed values: off, warning, error: \"invalid\"",
+		},
+		{
+			name:   "lint_words",
+			data:   "lint: {words: [wip, fixup]}",
+			config: config.Config{Lint: config.Lint{Words: []string{"wip", "fixup"}}},
+		},
+		{
+			name:   "lint_limit",
+			data:   "lint: {summaryLimit: 60, bodyLimit: 80}",
+			config: config.Config{Lint: config.Lint{SummaryLimit: 60, BodyLimit: 80}},
+		},
+		{
+			name: "signoff_invalid",
+			data: "commit: {signoff: invalid}",
+			err:  "invalid config: 1:19: commit.signoff: invalid value, expected a boolean: \"invalid\"",
 		},
 		{
 			name:   "theme_empty",
@@ -405,23 +477,7 @@ func TestLoad(t *testing.T) {
 				- name: John Doe
 				  email:
 			`),
-			config: config.Config{
-				View: config.View{
-					Focus:         config.FocusAuthor,
-					EmojiSet:      config.EmojiSetGitmoji,
-					EmojiSelector: config.EmojiSelectorBelow,
-					Compatibility: config.CompatibilityUnset,
-					Theme:         "builtin",
-					Colour:        config.ColourUnset,
-				},
-				Commit: config.Commit{
-					EmojiType: config.EmojiTypeShortcode,
-					Signoff:   false,
-				},
-				Authors: []repository.User{
-					{Name: "John Doe", Email: ""},
-				},
-			},
+			err: "invalid config: 5:20: view.compatibility: invalid value, allowed values: default, ttyd, kitty: \"none\"; 7:13: view.colour: invalid value, allowed values: adaptive, dark, light: \"blue\"",
 		},
 		{
 			name: "all_incorrect_type",
@@ -440,24 +496,7 @@ func TestLoad(t *testing.T) {
 				- name: John Doe
 				  email: john.doe@example.com
 			`),
-			config: config.Config{
-				View: config.View{
-					Focus:         config.FocusAuthor,
-					EmojiSet:      config.EmojiSetGitmoji,
-					EmojiSelector: config.EmojiSelectorBelow,
-					Compatibility: config.CompatibilityDefault,
-					Theme:         "builtin",
-					Colour:        config.ColourAdaptive,
-				},
-				Commit: config.Commit{
-					EmojiType: config.EmojiTypeShortcode,
-					Signoff:   false,
-				},
-				Authors: []repository.User{
-					{Name: "John Doe", Email: "john.doe@example.com"},
-				},
-			},
-			err: new(yaml.TypeError),
+			err: "invalid config: 10:14: commit.signoff: invalid value, expected a boolean: \"maybe\"",
 		},
 	}
 
@@ -467,13 +506,9 @@ func TestLoad(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			cfg, err := new(config.Config).Load(strings.NewReader(tt.data))
-			if tt.err != nil {
-				assert.NotNil(t, err)
-
-				var typeError *yaml.TypeError
-				assert.ErrorAs(t, err, &typeError)
-
+			cfg, err := new(config.Config).Load(strings.NewReader(tt.data), tt.opts)
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
 				return
 			}
 			assert.Nil(t, err)
@@ -536,6 +571,11 @@ func TestSave(t *testing.T) {
 			config: func(c *config.Config) { c.View.Compatibility = config.CompatibilityKitty },
 			data:   "view: {compatibility: kitty}",
 		},
+		{
+			name:   "view_editmode_vim",
+			config: func(c *config.Config) { c.View.EditMode = config.EditModeVim },
+			data:   "view: {editMode: vim}",
+		},
 		{
 			name:   "view_colour_unset",
 			config: func(c *config.Config) { c.View.Colour = config.ColourUnset },
@@ -645,6 +685,26 @@ func TestSave(t *testing.T) {
 			config: func(c *config.Config) { c.Commit.Signoff = true },
 			data:   "commit: {signoff: true}",
 		},
+		{
+			name:   "style_conventional",
+			config: func(c *config.Config) { c.Commit.Style = config.StyleConventional },
+			data:   "commit: {style: conventional}",
+		},
+		{
+			name:   "types",
+			config: func(c *config.Config) { c.Commit.Types = []string{"feat", "fix"} },
+			data:   "commit: {types: [feat, fix]}",
+		},
+		{
+			name:   "lint_severity",
+			config: func(c *config.Config) { c.Lint.RequireEmoji = config.SeverityError },
+			data:   "lint: {requireEmoji: error}",
+		},
+		{
+			name:   "lint_words",
+			config: func(c *config.Config) { c.Lint.Words = []string{"wip"} },
+			data:   "lint: {words: [wip]}",
+		},
 		{
 			name:   "highlightactive_false",
 			config: func(c *config.Config) { c.View.HighlightActive = false },
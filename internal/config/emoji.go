@@ -1,22 +1,24 @@
 package config
 
 import (
+	"reflect"
 	"strings"
 
 	"gopkg.in/yaml.v3"
 )
 
 type (
-	EmojiSet      int
+	EmojiSet      string
 	EmojiType     int
 	EmojiSelector int
 )
 
+// EmojiSet is the name of a built-in emoji set or a custom set.
 const (
-	EmojiSetUnset EmojiSet = iota
-	EmojiSetGitmoji
-	EmojiSetDevmoji
-	EmojiSetEmojiLog
+	EmojiSetUnset    EmojiSet = ""
+	EmojiSetGitmoji  EmojiSet = "gitmoji"
+	EmojiSetDevmoji  EmojiSet = "devmoji"
+	EmojiSetEmojiLog EmojiSet = "emojilog"
 )
 
 const (
@@ -38,12 +40,13 @@ func (e *EmojiSet) UnmarshalYAML(value *yaml.Node) error {
 }
 
 func (e EmojiSet) MarshalYAML() (interface{}, error) {
-	return []string{
-		"",
-		"gitmoji",
-		"devmoji",
-		"emojilog",
-	}[e], nil
+	return string(e), nil
+}
+
+// Builtin reports if the emoji set is one of the embedded sets. An unset
+// emoji set is the default built-in set.
+func (e EmojiSet) Builtin() bool {
+	return isAllowed(enums[reflect.TypeOf(e)], string(e), false)
 }
 
 func (e *EmojiType) UnmarshalYAML(value *yaml.Node) error {
@@ -74,6 +77,8 @@ func (e EmojiSelector) MarshalYAML() (interface{}, error) {
 	}[e], nil
 }
 
+// ParseEmojiSet returns a built-in emoji set, ignoring case, or otherwise
+// the name of a custom set.
 func ParseEmojiSet(str string) EmojiSet {
 	emojiSet := map[string]EmojiSet{
 		"":         EmojiSetUnset,
@@ -82,7 +87,11 @@ func ParseEmojiSet(str string) EmojiSet {
 		"emojilog": EmojiSetEmojiLog,
 	}
 
-	return emojiSet[strings.ToLower(str)]
+	if e, ok := emojiSet[strings.ToLower(str)]; ok {
+		return e
+	}
+
+	return EmojiSet(str)
 }
 
 func ParseEmojiType(str string) EmojiType {
@@ -21,7 +21,8 @@ func TestUnmarshallYAMLEmojiSet(t *testing.T) {
 		{name: "gitmoji", input: "gitmoji", want: config.EmojiSetGitmoji},
 		{name: "devmoji", input: "devmoji", want: config.EmojiSetDevmoji},
 		{name: "emojilog", input: "emojilog", want: config.EmojiSetEmojiLog},
-		{name: "invalid", input: "invalid", want: config.EmojiSetUnset},
+		{name: "case", input: "DevMoji", want: config.EmojiSetDevmoji},
+		{name: "custom", input: "Team", want: config.EmojiSet("Team")},
 	}
 
 	for _, tt := range tests {
@@ -106,7 +107,7 @@ func TestMarshallYAMLEmojiSet(t *testing.T) {
 		{name: "gitmoji", input: config.EmojiSetGitmoji, want: "gitmoji\n"},
 		{name: "devmoji", input: config.EmojiSetDevmoji, want: "devmoji\n"},
 		{name: "emojilog", input: config.EmojiSetEmojiLog, want: "emojilog\n"},
-		{name: "invalid", input: config.EmojiSetUnset, want: "\"\"\n"},
+		{name: "custom", input: config.EmojiSet("team"), want: "team\n"},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,148 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// CustomEmojiSet is an emoji set named in the config. Emojis are read from
+// files, or directories of files, with the same fields as the built-in
+// sets. A custom set may extend a built-in set and filter which of its
+// emojis are included.
+type CustomEmojiSet struct {
+	Name    string   `yaml:"name"`
+	Extends EmojiSet `yaml:"extends,omitempty"`
+	Include []string `yaml:"include,omitempty,flow"`
+	Exclude []string `yaml:"exclude,omitempty,flow"`
+	Paths   []string `yaml:"paths,omitempty,flow"`
+}
+
+const (
+	emojiSetKey  = "view.emojiSet"
+	emojiSetsKey = "emojiSets"
+)
+
+// FindEmojiSet returns the custom emoji set with a name.
+func (c Config) FindEmojiSet(name EmojiSet) (CustomEmojiSet, bool) {
+	for _, s := range c.EmojiSets {
+		if s.Name == string(name) {
+			return s, true
+		}
+	}
+
+	return CustomEmojiSet{}, false
+}
+
+// ResolvePaths expands the environment and home directory in each path.
+// Relative paths are relative to a directory, such as the worktree root.
+func (s CustomEmojiSet) ResolvePaths(dir string) []string {
+	ps := make([]string, len(s.Paths))
+
+	for i, p := range s.Paths {
+		p = expandHome(os.ExpandEnv(p))
+
+		if !filepath.IsAbs(p) && dir != "" {
+			p = filepath.Join(dir, p)
+		}
+
+		ps[i] = filepath.Clean(p)
+	}
+
+	return ps
+}
+
+// EmojiSetNames returns the names of the custom emoji sets.
+func EmojiSetNames(ss []CustomEmojiSet) []string {
+	ns := make([]string, len(ss))
+
+	for i, s := range ss {
+		ns[i] = s.Name
+	}
+
+	return ns
+}
+
+// declaredEmojiSets returns the names of the custom emoji sets within a
+// decoded config. Names of built-in sets are invalid and skipped.
+func declaredEmojiSets(n *yaml.Node) []string {
+	val := mappingValue(n, emojiSetsKey)
+	if val == nil || val.Kind != yaml.SequenceNode {
+		return nil
+	}
+
+	var ns []string
+
+	for _, item := range val.Content {
+		name := mappingValue(item, "name")
+		if name == nil || name.Kind != yaml.ScalarNode || ParseEmojiSet(name.Value).Builtin() {
+			continue
+		}
+
+		ns = append(ns, name.Value)
+	}
+
+	return ns
+}
+
+// emojiSets validates each custom emoji set. Problems are reported with the
+// set name, such as "emojiSets.team.extends".
+func (v validator) emojiSets(n *yaml.Node) []Problem {
+	var ps []Problem
+
+	for i, item := range n.Content {
+		label := fmt.Sprintf("%s.%d", emojiSetsKey, i)
+		if name := mappingValue(item, "name"); name != nil && name.Value != "" {
+			label = fmt.Sprintf("%s.%s", emojiSetsKey, name.Value)
+		}
+
+		if item.Kind != yaml.MappingNode {
+			ps = append(ps, v.problem(label, item, "expected a mapping"))
+
+			continue
+		}
+
+		sv := validator{file: v.file, opts: v.opts, label: label + keySeparator}
+
+		if mappingValue(item, "name") == nil {
+			ps = append(ps, Problem{
+				File:    v.file,
+				Line:    item.Line,
+				Column:  item.Column,
+				Key:     v.label + label,
+				Message: "missing name",
+			})
+		}
+
+		for j := 0; j+1 < len(item.Content); j += 2 {
+			k, val := item.Content[j], item.Content[j+1]
+
+			switch k.Value {
+			case "name":
+				if val.Kind != yaml.ScalarNode || val.Value == "" || ParseEmojiSet(val.Value).Builtin() {
+					ps = append(ps, sv.problem(k.Value, val, "invalid value, expected a name other than a built-in set"))
+				}
+			case "extends":
+				vs := Allowed(emojiSetKey)
+				if val.Kind != yaml.ScalarNode || !isAllowed(vs, val.Value, true) {
+					p := sv.problem(k.Value, val, fmt.Sprintf("invalid value, allowed values: %s", strings.Join(vs, ", ")))
+					p.Allowed = vs
+					ps = append(ps, p)
+				}
+			case "include", "exclude", "paths":
+				if err := val.Decode(new([]string)); err != nil {
+					ps = append(ps, sv.problem(k.Value, val, "invalid value, expected a list"))
+				}
+			default:
+				if v.opts.Strict {
+					ps = append(ps, sv.unknown("", k))
+				}
+			}
+		}
+	}
+
+	return ps
+}
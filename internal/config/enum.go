@@ -0,0 +1,81 @@
+package config
+
+import (
+	"reflect"
+	"strings"
+)
+
+// enums lists the values accepted by each enumerated type.
+var enums = map[reflect.Type][]string{
+	reflect.TypeOf(Focus(0)):         {"author", "emoji", "summary"},
+	reflect.TypeOf(EmojiSet("")):     {"gitmoji", "devmoji", "emojilog"},
+	reflect.TypeOf(EmojiSelector(0)): {"below", "above"},
+	reflect.TypeOf(EmojiType(0)):     {"shortcode", "character"},
+	reflect.TypeOf(Compatibility(0)): {"default", "ttyd", "kitty"},
+	reflect.TypeOf(Colour(0)):        {"adaptive", "dark", "light"},
+	reflect.TypeOf(EditMode(0)):      {"default", "vim"},
+	reflect.TypeOf(Style(0)):         {"emoji", "conventional"},
+	reflect.TypeOf(Severity(0)):      {"off", "warning", "error"},
+}
+
+// Allowed returns the values accepted by an enumerated key, or nil when
+// the key accepts any value of its type.
+func Allowed(key string) []string {
+	t, ok := keyType(key)
+	if !ok {
+		return nil
+	}
+
+	return enums[t]
+}
+
+// isAllowed reports if a value is one of the allowed values. Enumerated
+// values are parsed without case so they are folded before comparing.
+func isAllowed(vs []string, value string, fold bool) bool {
+	if value == "" {
+		return true
+	}
+
+	if fold {
+		value = strings.ToLower(value)
+	}
+
+	for _, v := range vs {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+func keyType(key string) (reflect.Type, bool) {
+	t := reflect.TypeOf(Config{})
+
+	for _, k := range strings.Split(key, keySeparator) {
+		if t.Kind() != reflect.Struct {
+			return nil, false
+		}
+
+		f, ok := fieldByKey(t, k)
+		if !ok {
+			return nil, false
+		}
+
+		t = f.Type
+	}
+
+	return t, true
+}
+
+func fieldByKey(t reflect.Type, key string) (reflect.StructField, bool) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+
+		if name, _, _ := strings.Cut(f.Tag.Get("yaml"), ","); name == key {
+			return f, true
+		}
+	}
+
+	return reflect.StructField{}, false
+}
@@ -0,0 +1,89 @@
+package config
+
+import (
+	"strings"
+)
+
+const (
+	envPrefix    = "COMMITTED_"
+	envSeparator = "_"
+)
+
+// EnvironmentOverrides converts COMMITTED_* variables into "key=value"
+// overrides. A variable is named after its key in upper case with dots
+// replaced by underscores, such as COMMITTED_VIEW_THEME, or after the
+// setting alone when the name is unique, such as COMMITTED_THEME. Unknown
+// variables are ignored and only reported by ValidateEnvironment.
+func EnvironmentOverrides(env []string) []string {
+	var kvs []string
+
+	for _, e := range environment(env) {
+		if e.key != "" {
+			kvs = append(kvs, e.key+"="+e.value)
+		}
+	}
+
+	return kvs
+}
+
+// ValidateEnvironment returns a problem for each COMMITTED_* variable that
+// does not name a key.
+func ValidateEnvironment(env []string) []Problem {
+	var ps []Problem
+
+	for _, e := range environment(env) {
+		if e.key == "" {
+			ps = append(ps, Problem{Key: e.name, Message: "unknown key"})
+		}
+	}
+
+	return ps
+}
+
+type variable struct {
+	name  string
+	key   string
+	value string
+}
+
+// environment returns the COMMITTED_* variables with the key each names, or
+// no key when the name is unknown.
+func environment(env []string) []variable {
+	var vs []variable
+
+	for _, e := range env {
+		name, value, ok := strings.Cut(e, "=")
+		if !ok || !strings.HasPrefix(name, envPrefix) {
+			continue
+		}
+
+		key, _ := resolveKey(strings.ReplaceAll(strings.TrimPrefix(name, envPrefix), envSeparator, keySeparator))
+
+		vs = append(vs, variable{name: name, key: key, value: value})
+	}
+
+	return vs
+}
+
+// resolveKey finds the key for a name regardless of case. A name may also
+// be the last part of a key when no other key ends with it.
+func resolveKey(name string) (string, bool) {
+	var found []string
+
+	for _, k := range Keys() {
+		if strings.EqualFold(k, name) {
+			return k, true
+		}
+
+		ks := strings.Split(k, keySeparator)
+		if strings.EqualFold(ks[len(ks)-1], name) {
+			found = append(found, k)
+		}
+	}
+
+	if len(found) != 1 {
+		return "", false
+	}
+
+	return found[0], true
+}
@@ -0,0 +1,97 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/mikelorant/committed/internal/config"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnvironmentOverrides(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		env  []string
+		want []string
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name: "ignored",
+			env:  []string{"HOME=/root", "COMMITTED", "EDITOR=vi"},
+		},
+		{
+			name: "full_key",
+			env:  []string{"COMMITTED_VIEW_THEME=nord", "COMMITTED_COMMIT_SIGNOFF=true"},
+			want: []string{"view.theme=nord", "commit.signoff=true"},
+		},
+		{
+			name: "mixed_case",
+			env:  []string{"COMMITTED_VIEW_EMOJISET=devmoji"},
+			want: []string{"view.emojiSet=devmoji"},
+		},
+		{
+			name: "setting",
+			env:  []string{"COMMITTED_THEME=nord"},
+			want: []string{"view.theme=nord"},
+		},
+		{
+			name: "empty_value",
+			env:  []string{"COMMITTED_THEME="},
+			want: []string{"view.theme="},
+		},
+		{
+			name: "unknown",
+			env:  []string{"COMMITTED_UNKNOWN=true", "COMMITTED_THEME=nord"},
+			want: []string{"view.theme=nord"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, config.EnvironmentOverrides(tt.env))
+		})
+	}
+}
+
+func TestValidateEnvironment(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		env  []string
+		want []config.Problem
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name: "known",
+			env:  []string{"HOME=/root", "COMMITTED_VIEW_THEME=nord", "COMMITTED_THEME=nord"},
+		},
+		{
+			name: "unknown",
+			env:  []string{"COMMITTED_UNKNOWN=true", "COMMITTED_THEME=nord"},
+			want: []config.Problem{
+				{Key: "COMMITTED_UNKNOWN", Message: "unknown key"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, config.ValidateEnvironment(tt.env))
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package config
+
+import (
+	"bytes"
+	_ "embed"
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultFile is a config file with every setting documented and set to its
+// default value.
+//
+//go:embed default.yaml
+var DefaultFile string
+
+const indent = 2
+
+// Set changes the value of a key within a config file. Comments and the
+// order of existing settings are kept.
+func Set(src []byte, key, value string, opts Options) ([]byte, error) {
+	doc, err := decodeDocument(src)
+	if err != nil {
+		return nil, err
+	}
+
+	root := doc.Content[0]
+
+	if err := SetNode(root, key, value); err != nil {
+		return nil, err
+	}
+
+	if p, ok := (validator{opts: opts}).value(key, lookupNode(root, key)); !ok {
+		p.Line, p.Column = 0, 0
+
+		return nil, p
+	}
+
+	return encodeDocument(doc)
+}
+
+// decodeDocument decodes a config file keeping its comments. An empty file
+// is an empty mapping.
+func decodeDocument(src []byte) (*yaml.Node, error) {
+	var doc yaml.Node
+
+	if err := yaml.Unmarshal(src, &doc); err != nil {
+		return nil, fmt.Errorf("unable to decode config: %w", err)
+	}
+
+	if doc.Kind == 0 {
+		doc = yaml.Node{Kind: yaml.DocumentNode}
+	}
+
+	if len(doc.Content) == 0 || doc.Content[0].Tag == "!!null" {
+		doc.Content = []*yaml.Node{{Kind: yaml.MappingNode, Tag: "!!map"}}
+	}
+
+	if doc.Content[0].Kind != yaml.MappingNode {
+		return nil, ErrMapping
+	}
+
+	return &doc, nil
+}
+
+func encodeDocument(doc *yaml.Node) ([]byte, error) {
+	var buf bytes.Buffer
+
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(indent)
+
+	if err := enc.Encode(doc); err != nil {
+		return nil, fmt.Errorf("unable to encode config: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,154 @@
+package config_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/mikelorant/committed/internal/config"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDefaultFile(t *testing.T) {
+	t.Parallel()
+
+	cfg, _, err := new(config.Config).Merge(config.Options{}, config.Input{Reader: strings.NewReader(config.DefaultFile)})
+	assert.NoError(t, err)
+
+	assert.Equal(t, config.FocusEmoji, cfg.View.Focus)
+	assert.Equal(t, config.EmojiSetGitmoji, cfg.View.EmojiSet)
+	assert.Equal(t, config.SeverityError, cfg.Lint.BlankLine)
+	assert.Equal(t, 72, cfg.Lint.BodyLimit)
+}
+
+func TestSet(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		src   string
+		key   string
+		value string
+	}
+
+	type want struct {
+		out string
+		err string
+	}
+
+	tests := []struct {
+		name string
+		args args
+		want want
+	}{
+		{
+			name: "empty",
+			args: args{
+				key:   "view.theme",
+				value: "nord",
+			},
+			want: want{
+				out: heredoc.Doc(`
+					view:
+					  theme: nord
+				`),
+			},
+		},
+		{
+			name: "replace",
+			args: args{
+				src: heredoc.Doc(`
+					# Settings.
+					view:
+					  # Theme to display.
+					  theme: dracula
+					  emojiSet: devmoji
+				`),
+				key:   "view.theme",
+				value: "nord",
+			},
+			want: want{
+				out: heredoc.Doc(`
+					# Settings.
+					view:
+					  # Theme to display.
+					  theme: nord
+					  emojiSet: devmoji
+				`),
+			},
+		},
+		{
+			name: "add_group",
+			args: args{
+				src:   "view: {theme: nord}",
+				key:   "commit.scopes",
+				value: "[ui, cmd]",
+			},
+			want: want{
+				out: heredoc.Doc(`
+					view: {theme: nord}
+					commit:
+					  scopes: [ui, cmd]
+				`),
+			},
+		},
+		{
+			name: "unknown_key",
+			args: args{
+				key:   "view.unknown",
+				value: "true",
+			},
+			want: want{
+				err: "unknown key: view.unknown",
+			},
+		},
+		{
+			name: "invalid_enum",
+			args: args{
+				key:   "commit.style",
+				value: "invalid",
+			},
+			want: want{
+				err: `commit.style: invalid value, allowed values: emoji, conventional: "invalid"`,
+			},
+		},
+		{
+			name: "invalid_type",
+			args: args{
+				key:   "commit.signoff",
+				value: "maybe",
+			},
+			want: want{
+				err: `commit.signoff: invalid value, expected a boolean: "maybe"`,
+			},
+		},
+		{
+			name: "not_mapping",
+			args: args{
+				src:   "invalid",
+				key:   "view.theme",
+				value: "nord",
+			},
+			want: want{
+				err: "config must be a mapping",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			out, err := config.Set([]byte(tt.args.src), tt.args.key, tt.args.value, config.Options{})
+			if tt.want.err != "" {
+				assert.EqualError(t, err, tt.want.err)
+				return
+			}
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.want.out, string(out))
+		})
+	}
+}
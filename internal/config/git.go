@@ -0,0 +1,82 @@
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	gitconfig "github.com/go-git/go-git/v5/plumbing/format/config"
+)
+
+const gitSection = "committed"
+
+// GitOverrides converts the options of the committed section of each git
+// config into "key=value" overrides. Options are named after their key,
+// such as committed.view.theme, or after the setting alone when the name is
+// unique, such as committed.emojiSet. Later configs replace earlier ones.
+// Repeating an option that is a list, such as committed.scopes, adds to it.
+func GitOverrides(cfgs ...*gitconfig.Config) ([]string, error) {
+	var (
+		order  []string
+		values = make(map[string][]string)
+	)
+
+	for _, cfg := range cfgs {
+		if cfg == nil || !cfg.HasSection(gitSection) {
+			continue
+		}
+
+		sect := cfg.Section(gitSection)
+		seen := make(map[string]bool)
+
+		add := func(name, value string) error {
+			key, ok := resolveKey(name)
+			if !ok {
+				return fmt.Errorf("%w: %v.%v", ErrKey, gitSection, name)
+			}
+
+			if _, ok := values[key]; !ok {
+				order = append(order, key)
+			}
+
+			// Options in a later config replace those of an earlier one.
+			if !seen[key] {
+				values[key] = nil
+				seen[key] = true
+			}
+
+			values[key] = append(values[key], value)
+
+			return nil
+		}
+
+		for _, o := range sect.Options {
+			if err := add(o.Key, o.Value); err != nil {
+				return nil, err
+			}
+		}
+
+		for _, sub := range sect.Subsections {
+			for _, o := range sub.Options {
+				if err := add(sub.Name+keySeparator+o.Key, o.Value); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	var kvs []string
+
+	for _, key := range order {
+		vs := values[key]
+
+		v := vs[len(vs)-1]
+		if t, _ := keyType(key); t.Kind() == reflect.Slice && len(vs) > 1 {
+			v = "[" + strings.Join(vs, ", ") + "]"
+		}
+
+		kvs = append(kvs, key+"="+v)
+	}
+
+	return kvs, nil
+}
@@ -0,0 +1,114 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/mikelorant/committed/internal/config"
+
+	gitconfig "github.com/go-git/go-git/v5/plumbing/format/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGitOverrides(t *testing.T) {
+	t.Parallel()
+
+	type want struct {
+		kvs []string
+		err string
+	}
+
+	tests := []struct {
+		name string
+		cfgs []*gitconfig.Config
+		want want
+	}{
+		{
+			name: "empty",
+			cfgs: []*gitconfig.Config{nil, gitconfig.New()},
+		},
+		{
+			name: "other_section",
+			cfgs: []*gitconfig.Config{
+				gitconfig.New().AddOption("user", "", "name", "John Doe"),
+			},
+		},
+		{
+			name: "setting",
+			cfgs: []*gitconfig.Config{
+				gitconfig.New().AddOption("committed", "", "emojiSet", "devmoji"),
+			},
+			want: want{
+				kvs: []string{"view.emojiSet=devmoji"},
+			},
+		},
+		{
+			name: "subsection",
+			cfgs: []*gitconfig.Config{
+				gitconfig.New().AddOption("committed", "view", "theme", "nord"),
+			},
+			want: want{
+				kvs: []string{"view.theme=nord"},
+			},
+		},
+		{
+			name: "later_replaces_earlier",
+			cfgs: []*gitconfig.Config{
+				gitconfig.New().
+					AddOption("committed", "", "theme", "nord").
+					AddOption("committed", "", "signoff", "true"),
+				gitconfig.New().AddOption("committed", "view", "theme", "dracula"),
+			},
+			want: want{
+				kvs: []string{"view.theme=dracula", "commit.signoff=true"},
+			},
+		},
+		{
+			name: "repeated_list",
+			cfgs: []*gitconfig.Config{
+				gitconfig.New().
+					AddOption("committed", "", "scopes", "ui").
+					AddOption("committed", "", "scopes", "cmd"),
+			},
+			want: want{
+				kvs: []string{"commit.scopes=[ui, cmd]"},
+			},
+		},
+		{
+			name: "repeated_scalar",
+			cfgs: []*gitconfig.Config{
+				gitconfig.New().
+					AddOption("committed", "", "theme", "nord").
+					AddOption("committed", "", "theme", "dracula"),
+			},
+			want: want{
+				kvs: []string{"view.theme=dracula"},
+			},
+		},
+		{
+			name: "unknown",
+			cfgs: []*gitconfig.Config{
+				gitconfig.New().AddOption("committed", "", "unknown", "true"),
+			},
+			want: want{
+				err: "unknown key: committed.unknown",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			kvs, err := config.GitOverrides(tt.cfgs...)
+			if tt.want.err != "" {
+				assert.EqualError(t, err, tt.want.err)
+				return
+			}
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.want.kvs, kvs)
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const keysKey = "keys"
+
+// keys validates the key bindings. Each action is bound to a list of keys
+// such as "alt+enter" or "ctrl+s". Problems are reported with the action,
+// such as "keys.commit".
+func (v validator) keys(n *yaml.Node) []Problem {
+	if n.Kind != yaml.MappingNode {
+		return []Problem{v.problem(keysKey, n, "invalid value, expected a mapping")}
+	}
+
+	var ps []Problem
+
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		k, val := n.Content[i], n.Content[i+1]
+		key := joinKey(keysKey, k.Value)
+
+		if len(v.opts.Actions) > 0 && !isAllowed(v.opts.Actions, k.Value, false) {
+			p := v.problem(key, k, fmt.Sprintf("unknown action, allowed actions: %s", strings.Join(v.opts.Actions, ", ")))
+			p.Allowed = v.opts.Actions
+			ps = append(ps, p)
+
+			continue
+		}
+
+		if !isKeyList(val) {
+			ps = append(ps, v.problem(key, val, "invalid value, expected a list of keys"))
+		}
+	}
+
+	return ps
+}
+
+// isKeyList reports if a node is a list of at least one key.
+func isKeyList(n *yaml.Node) bool {
+	if n.Kind != yaml.SequenceNode || len(n.Content) == 0 {
+		return false
+	}
+
+	for _, k := range n.Content {
+		if k.Kind != yaml.ScalarNode || k.Value == "" {
+			return false
+		}
+	}
+
+	return true
+}
+
+// mergeKeys returns the key bindings of an earlier layer with the keys of
+// each action in the layer replaced, so that a layer only needs to contain
+// the actions it changes.
+func mergeKeys(dst, src *yaml.Node) *yaml.Node {
+	n := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+
+	if dst != nil && dst.Kind == yaml.MappingNode {
+		n.Content = append(n.Content, dst.Content...)
+	}
+
+	for i := 0; i+1 < len(src.Content); i += 2 {
+		setMappingValue(n, src.Content[i].Value, src.Content[i+1])
+	}
+
+	return n
+}
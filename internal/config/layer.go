@@ -0,0 +1,549 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Layer is a level of configuration. Values from later layers replace
+// those of earlier layers.
+type Layer int
+
+const (
+	LayerUnset Layer = iota
+	LayerDefault
+	LayerGlobal
+	LayerGit
+	LayerProject
+	LayerRepository
+	LayerProfile
+	LayerEnvironment
+	LayerFlag
+)
+
+// Source describes where a configuration value was supplied.
+type Source struct {
+	Layer   Layer
+	File    string
+	Profile string
+}
+
+// Sources maps keys such as "view.theme" to the source of their value.
+type Sources map[string]Source
+
+// Input is a layer of configuration to be merged.
+type Input struct {
+	Source Source
+	Reader io.Reader
+}
+
+// Value is an effective configuration value and its source.
+type Value struct {
+	Key    string
+	Value  string
+	Source Source
+}
+
+const (
+	RepositoryFile = ".committed.yaml"
+
+	// DefaultGlobalFile is the global config file when $XDG_CONFIG_HOME is
+	// not set.
+	DefaultGlobalFile = "$HOME/.config/committed/config.yaml"
+
+	xdgGlobalFile = "$XDG_CONFIG_HOME/committed/config.yaml"
+	keySeparator  = "."
+)
+
+var (
+	ErrKey      = errors.New("unknown key")
+	ErrMapping  = errors.New("config must be a mapping")
+	ErrOverride = errors.New("invalid override")
+	ErrProfile  = errors.New("unknown profile")
+	ErrProject  = errors.New("invalid project config")
+	ErrVersion  = errors.New("unsupported config version")
+)
+
+func (l Layer) String() string {
+	return [...]string{
+		"",
+		"default",
+		"global",
+		"git",
+		"project",
+		"repository",
+		"profile",
+		"environment",
+		"flag",
+	}[l]
+}
+
+func (s Source) String() string {
+	switch {
+	case s.Profile != "":
+		return fmt.Sprintf("%s (%s)", s.Layer, s.Profile)
+	case s.File != "":
+		return fmt.Sprintf("%s (%s)", s.Layer, s.File)
+	}
+
+	return s.Layer.String()
+}
+
+// Get returns the source of a key. Keys not supplied by any layer use the
+// default.
+func (s Sources) Get(key string) Source {
+	if src, ok := s[key]; ok {
+		return src
+	}
+
+	return Source{Layer: LayerDefault}
+}
+
+// GlobalFile returns the location of the global config file, respecting
+// $XDG_CONFIG_HOME when set.
+func GlobalFile() string {
+	if os.Getenv("XDG_CONFIG_HOME") != "" {
+		return xdgGlobalFile
+	}
+
+	return DefaultGlobalFile
+}
+
+// Merge decodes each input in order. Values are replaced key by key so a
+// later layer only needs to contain the settings it changes. Every layer is
+// validated and the problems found are returned as a ValidationError. The
+// selected profile is applied after the files and git config and before the
+// environment and flags.
+func (c *Config) Merge(opts Options, ins ...Input) (Config, Sources, error) {
+	root := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+	srcs := make(Sources)
+
+	var (
+		ps      []Problem
+		profile string
+		applied bool
+	)
+
+	apply := func() error {
+		if len(ps) > 0 {
+			return nil
+		}
+
+		name, err := applyProfile(root, opts, srcs)
+		profile, applied = name, true
+
+		return err
+	}
+
+	ns, opts, err := decodeInputs(ins, true, opts)
+	if err != nil {
+		return Config{}, nil, err
+	}
+
+	for i, in := range ins {
+		if in.Source.Layer >= LayerEnvironment && !applied {
+			if err := apply(); err != nil {
+				return Config{}, nil, err
+			}
+		}
+
+		if ns[i] == nil {
+			continue
+		}
+
+		ps = append(ps, inputProblems(in.Source, ns[i], opts)...)
+
+		mergeNode(root, ns[i], "", in.Source, srcs)
+	}
+
+	if len(ps) > 0 {
+		return Config{}, nil, &ValidationError{Problems: ps}
+	}
+
+	if !applied {
+		if err := apply(); err != nil {
+			return Config{}, nil, err
+		}
+	}
+
+	var cfg Config
+
+	if err := root.Decode(&cfg); err != nil {
+		return Config{}, nil, fmt.Errorf("unable to decode config: %w", err)
+	}
+
+	cfg.Profile = profile
+
+	return cfg, srcs, nil
+}
+
+// Keys returns every configurable key in dotted form.
+func Keys() []string {
+	return keys(reflect.TypeOf(Config{}), "")
+}
+
+// HasKey reports if the key is configurable.
+func HasKey(key string) bool {
+	for _, k := range Keys() {
+		if k == key {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Overrides converts "key=value" pairs into a config layer. Values are
+// parsed as YAML so lists can be given in flow style such as "[a, b]".
+func Overrides(kvs []string) (io.Reader, error) {
+	root := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+
+	for _, kv := range kvs {
+		k, v, ok := strings.Cut(kv, "=")
+		if !ok {
+			return nil, fmt.Errorf("%w: %v", ErrOverride, kv)
+		}
+
+		if err := SetNode(root, k, v); err != nil {
+			return nil, err
+		}
+	}
+
+	out, err := yaml.Marshal(root)
+	if err != nil {
+		return nil, fmt.Errorf("unable to encode overrides: %w", err)
+	}
+
+	return strings.NewReader(string(out)), nil
+}
+
+// SetNode sets the value of a key within a mapping node, creating any
+// intermediate mappings.
+func SetNode(root *yaml.Node, key, value string) error {
+	if !HasKey(key) {
+		return fmt.Errorf("%w: %v", ErrKey, key)
+	}
+
+	var doc yaml.Node
+	if err := yaml.Unmarshal([]byte(value), &doc); err != nil {
+		return fmt.Errorf("unable to parse value: %v: %w", value, err)
+	}
+
+	val := &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!null"}
+	if len(doc.Content) > 0 {
+		val = doc.Content[0]
+	}
+
+	n := root
+	ks := strings.Split(key, keySeparator)
+
+	for _, k := range ks[:len(ks)-1] {
+		child := mappingValue(n, k)
+		if child == nil || child.Kind != yaml.MappingNode {
+			child = &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+			setMappingValue(n, k, child)
+		}
+
+		n = child
+	}
+
+	setMappingValue(n, ks[len(ks)-1], val)
+
+	return nil
+}
+
+// Values returns the effective value and source of every key. Keys that are
+// not set take their value from the default config file.
+func Values(cfg Config, srcs Sources) ([]Value, error) {
+	var root, def yaml.Node
+
+	if err := root.Encode(cfg); err != nil {
+		return nil, fmt.Errorf("unable to encode config: %w", err)
+	}
+
+	if err := yaml.Unmarshal([]byte(DefaultFile), &def); err != nil {
+		return nil, fmt.Errorf("unable to decode default config: %w", err)
+	}
+
+	var vs []Value
+
+	for _, k := range Keys() {
+		v, err := nodeString(lookupNode(&root, k))
+		if err != nil {
+			return nil, fmt.Errorf("unable to encode value: %v: %w", k, err)
+		}
+
+		if v == "" {
+			v, err = defaultValue(lookupNode(&def, k), srcs.Get(k))
+			if err != nil {
+				return nil, fmt.Errorf("unable to encode default value: %v: %w", k, err)
+			}
+		}
+
+		// Profiles, emoji sets and themes are listed by name as their
+		// settings are too long to show.
+		switch {
+		case k == profilesKey && len(cfg.Profiles) > 0:
+			v = "[" + strings.Join(Names(cfg.Profiles), ", ") + "]"
+		case k == emojiSetsKey && len(cfg.EmojiSets) > 0:
+			v = "[" + strings.Join(EmojiSetNames(cfg.EmojiSets), ", ") + "]"
+		case k == themesKey && len(cfg.Themes) > 0:
+			v = "[" + strings.Join(ThemeNames(cfg.Themes), ", ") + "]"
+		}
+
+		vs = append(vs, Value{
+			Key:    k,
+			Value:  v,
+			Source: srcs.Get(k),
+		})
+	}
+
+	return vs, nil
+}
+
+// decodeInputs decodes each input, migrating them to the current version
+// when asked. The custom emoji sets and themes declared by every input are
+// added to the options so that any layer may select them.
+func decodeInputs(ins []Input, migrate bool, opts Options) ([]*yaml.Node, Options, error) {
+	ns := make([]*yaml.Node, len(ins))
+
+	for i, in := range ins {
+		n, err := decodeNode(in.Reader)
+		if err != nil {
+			return nil, opts, fmt.Errorf("unable to decode config: %v: %w", in.Source, err)
+		}
+
+		if n == nil {
+			continue
+		}
+
+		if migrate {
+			if _, err := migrateNode(n); err != nil {
+				return nil, opts, fmt.Errorf("unable to migrate config: %v: %w", in.Source, err)
+			}
+		}
+
+		ns[i] = n
+		opts = opts.declare(n)
+	}
+
+	return ns, opts, nil
+}
+
+// declare adds the custom emoji sets and themes declared within a decoded
+// config to the options. Themes are only added when the valid themes are
+// limited.
+func (o Options) declare(n *yaml.Node) Options {
+	for _, name := range declaredEmojiSets(n) {
+		if !isAllowed(o.EmojiSets, name, false) {
+			o.EmojiSets = append(o.EmojiSets[:len(o.EmojiSets):len(o.EmojiSets)], name)
+		}
+	}
+
+	if len(o.Themes) == 0 {
+		return o
+	}
+
+	for _, name := range declaredThemes(n) {
+		if !isAllowed(o.Themes, name, false) {
+			o.Themes = append(o.Themes[:len(o.Themes):len(o.Themes)], name)
+		}
+	}
+
+	return o
+}
+
+func decodeNode(r io.Reader) (*yaml.Node, error) {
+	var doc yaml.Node
+
+	err := yaml.NewDecoder(r).Decode(&doc)
+	switch {
+	case err == nil:
+	case errors.Is(err, io.EOF):
+		return nil, nil
+	default:
+		return nil, err
+	}
+
+	n := doc.Content[0]
+
+	switch {
+	case n.Tag == "!!null":
+		return nil, nil
+	case n.Kind != yaml.MappingNode:
+		return nil, ErrMapping
+	}
+
+	return n, nil
+}
+
+func mergeNode(dst, src *yaml.Node, prefix string, s Source, srcs Sources) {
+	for i := 0; i+1 < len(src.Content); i += 2 {
+		k, v := src.Content[i], src.Content[i+1]
+		key := joinKey(prefix, k.Value)
+
+		// A group containing only comments does not replace earlier layers.
+		if v.Tag == "!!null" && hasPrefixKey(key) {
+			continue
+		}
+
+		if key == keysKey && v.Kind == yaml.MappingNode {
+			setMappingValue(dst, k.Value, mergeKeys(mappingValue(dst, k.Value), v))
+			srcs[key] = s
+
+			continue
+		}
+
+		if v.Kind != yaml.MappingNode || !hasPrefixKey(key) {
+			setMappingValue(dst, k.Value, v)
+			srcs[key] = s
+
+			continue
+		}
+
+		child := mappingValue(dst, k.Value)
+		if child == nil || child.Kind != yaml.MappingNode {
+			child = &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+			setMappingValue(dst, k.Value, child)
+		}
+
+		mergeNode(child, v, key, s, srcs)
+	}
+}
+
+func mappingValue(n *yaml.Node, key string) *yaml.Node {
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		if n.Content[i].Value == key {
+			return n.Content[i+1]
+		}
+	}
+
+	return nil
+}
+
+func setMappingValue(n *yaml.Node, key string, val *yaml.Node) {
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		if n.Content[i].Value == key {
+			n.Content[i+1] = val
+
+			return
+		}
+	}
+
+	k := &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: key}
+	n.Content = append(n.Content, k, val)
+}
+
+func lookupNode(n *yaml.Node, key string) *yaml.Node {
+	if n.Kind == yaml.DocumentNode && len(n.Content) > 0 {
+		n = n.Content[0]
+	}
+
+	for _, k := range strings.Split(key, keySeparator) {
+		if n == nil || n.Kind != yaml.MappingNode {
+			return nil
+		}
+
+		n = mappingValue(n, k)
+	}
+
+	return n
+}
+
+// defaultValue returns the value of a key that is empty once merged. An unset
+// key has the default value, while a key set to zero is omitted when encoded
+// so is given the zero value of the default.
+func defaultValue(n *yaml.Node, src Source) (string, error) {
+	if src.Layer == LayerDefault {
+		return nodeString(n)
+	}
+
+	if n == nil || n.Kind != yaml.ScalarNode {
+		return "", nil
+	}
+
+	switch n.Tag {
+	case "!!bool":
+		return "false", nil
+	case "!!int":
+		return "0", nil
+	}
+
+	return "", nil
+}
+
+func nodeString(n *yaml.Node) (string, error) {
+	if n == nil {
+		return "", nil
+	}
+
+	if n.Kind == yaml.ScalarNode {
+		return n.Value, nil
+	}
+
+	n.Style = yaml.FlowStyle
+
+	out, err := yaml.Marshal(n)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(string(out)), nil
+}
+
+// hasPrefixKey reports if the key groups other keys.
+func hasPrefixKey(key string) bool {
+	pfx := key + keySeparator
+
+	for _, k := range Keys() {
+		if strings.HasPrefix(k, pfx) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func keys(t reflect.Type, prefix string) []string {
+	var ks []string
+
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+
+		name, _, _ := strings.Cut(f.Tag.Get("yaml"), ",")
+		if name == "" || name == "-" {
+			continue
+		}
+
+		key := joinKey(prefix, name)
+
+		// The version describes the file format rather than a setting.
+		if key == versionKey {
+			continue
+		}
+
+		if f.Type.Kind() == reflect.Struct {
+			ks = append(ks, keys(f.Type, key)...)
+
+			continue
+		}
+
+		ks = append(ks, key)
+	}
+
+	return ks
+}
+
+func joinKey(prefix, key string) string {
+	if prefix == "" {
+		return key
+	}
+
+	return prefix + keySeparator + key
+}
@@ -0,0 +1,336 @@
+package config_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/mikelorant/committed/internal/config"
+	"github.com/mikelorant/committed/internal/repository"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	globalSource = config.Source{Layer: config.LayerGlobal, File: "config.yaml"}
+	repoSource   = config.Source{Layer: config.LayerRepository, File: ".committed.yaml"}
+	flagSource   = config.Source{Layer: config.LayerFlag}
+)
+
+func TestMerge(t *testing.T) {
+	t.Parallel()
+
+	type want struct {
+		config  config.Config
+		sources config.Sources
+		err     string
+	}
+
+	tests := []struct {
+		name   string
+		global string
+		repo   string
+		flag   string
+		want   want
+	}{
+		{
+			name: "empty",
+			want: want{
+				sources: config.Sources{},
+			},
+		},
+		{
+			name:   "global",
+			global: "view: {theme: nord}",
+			want: want{
+				config:  config.Config{View: config.View{Theme: "nord"}},
+				sources: config.Sources{"view.theme": globalSource},
+			},
+		},
+		{
+			name:   "repository_overrides_global",
+			global: "view: {theme: nord, emojiSet: gitmoji}",
+			repo:   "view: {emojiSet: devmoji}",
+			want: want{
+				config: config.Config{View: config.View{Theme: "nord", EmojiSet: config.EmojiSetDevmoji}},
+				sources: config.Sources{
+					"view.theme":    globalSource,
+					"view.emojiSet": repoSource,
+				},
+			},
+		},
+		{
+			name:   "repository_disables_global",
+			global: "commit: {signoff: true}",
+			repo:   "commit: {signoff: false}",
+			want: want{
+				sources: config.Sources{"commit.signoff": repoSource},
+			},
+		},
+		{
+			name:   "flag_overrides_repository",
+			global: "commit: {signoff: true}",
+			repo:   "commit: {signoff: false}",
+			flag:   "commit: {signoff: true}",
+			want: want{
+				config:  config.Config{Commit: config.Commit{Signoff: true}},
+				sources: config.Sources{"commit.signoff": flagSource},
+			},
+		},
+		{
+			name:   "comments_only_group",
+			global: "view: {theme: nord}",
+			repo:   "view:\n  # theme: dracula\n",
+			want: want{
+				config:  config.Config{View: config.View{Theme: "nord"}},
+				sources: config.Sources{"view.theme": globalSource},
+			},
+		},
+		{
+			name: "lists_replaced",
+			global: heredoc.Doc(`
+				authors:
+				  - name: John Doe
+				    email: john.doe@example.com
+				  - name: Jane Doe
+				    email: jane.doe@example.com
+			`),
+			repo: heredoc.Doc(`
+				authors:
+				  - name: John Doe
+				    email: jdoe@example.org
+			`),
+			want: want{
+				config: config.Config{
+					Authors: []repository.User{
+						{Name: "John Doe", Email: "jdoe@example.org"},
+					},
+				},
+				sources: config.Sources{"authors": repoSource},
+			},
+		},
+		{
+			name:   "emoji_set_declared_in_other_layer",
+			global: "emojiSets: [{name: team, paths: [emojis]}]",
+			repo:   "view: {emojiSet: team}",
+			want: want{
+				config: config.Config{
+					View:      config.View{EmojiSet: "team"},
+					EmojiSets: []config.CustomEmojiSet{{Name: "team", Paths: []string{"emojis"}}},
+				},
+				sources: config.Sources{
+					"emojiSets":     globalSource,
+					"view.emojiSet": repoSource,
+				},
+			},
+		},
+		{
+			name:   "theme_declared_in_other_layer",
+			global: "themes: [{name: acme, accent: '#0a84ff'}]",
+			repo:   "view: {theme: acme}",
+			want: want{
+				config: config.Config{
+					View:   config.View{Theme: "acme"},
+					Themes: []config.CustomTheme{{Name: "acme", Accent: "#0a84ff"}},
+				},
+				sources: config.Sources{
+					"themes":     globalSource,
+					"view.theme": repoSource,
+				},
+			},
+		},
+		{
+			name:   "keys_merged_by_action",
+			global: "keys: {commit: [ctrl+s], help: [f1]}",
+			repo:   "keys: {help: [f2]}",
+			want: want{
+				config: config.Config{
+					Keys: map[string][]string{
+						"commit": {"ctrl+s"},
+						"help":   {"f2"},
+					},
+				},
+				sources: config.Sources{"keys": repoSource},
+			},
+		},
+		{
+			name:   "invalid_values",
+			global: "view: {emojiSet: gitmojis}",
+			flag:   "commit: {signoff: maybe}",
+			want: want{
+				err: `invalid config: config.yaml:1:18: view.emojiSet: invalid value, allowed values: gitmoji, devmoji, emojilog: "gitmojis"; ` +
+					`flag: commit.signoff: invalid value, expected a boolean: "maybe"`,
+			},
+		},
+		{
+			name:   "not_mapping",
+			global: "invalid",
+			want: want{
+				err: "unable to decode config: global (config.yaml): config must be a mapping",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg, srcs, err := new(config.Config).Merge(config.Options{},
+				config.Input{Source: globalSource, Reader: strings.NewReader(tt.global)},
+				config.Input{Source: repoSource, Reader: strings.NewReader(tt.repo)},
+				config.Input{Source: flagSource, Reader: strings.NewReader(tt.flag)},
+			)
+			if tt.want.err != "" {
+				assert.EqualError(t, err, tt.want.err)
+				return
+			}
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.want.config, cfg)
+			assert.Equal(t, tt.want.sources, srcs)
+		})
+	}
+}
+
+func TestOverrides(t *testing.T) {
+	t.Parallel()
+
+	type want struct {
+		config config.Config
+		err    string
+	}
+
+	tests := []struct {
+		name string
+		kvs  []string
+		want want
+	}{
+		{
+			name: "scalar",
+			kvs:  []string{"view.theme=nord", "commit.signoff=true"},
+			want: want{
+				config: config.Config{
+					View:   config.View{Theme: "nord"},
+					Commit: config.Commit{Signoff: true},
+				},
+			},
+		},
+		{
+			name: "enum",
+			kvs:  []string{"view.emojiSet=devmoji"},
+			want: want{
+				config: config.Config{View: config.View{EmojiSet: config.EmojiSetDevmoji}},
+			},
+		},
+		{
+			name: "list",
+			kvs:  []string{"commit.scopes=[ui, cmd]"},
+			want: want{
+				config: config.Config{Commit: config.Commit{Scopes: []string{"ui", "cmd"}}},
+			},
+		},
+		{
+			name: "unknown_key",
+			kvs:  []string{"view.unknown=true"},
+			want: want{
+				err: "unknown key: view.unknown",
+			},
+		},
+		{
+			name: "group_key",
+			kvs:  []string{"view=true"},
+			want: want{
+				err: "unknown key: view",
+			},
+		},
+		{
+			name: "invalid",
+			kvs:  []string{"view.theme"},
+			want: want{
+				err: "invalid override: view.theme",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			r, err := config.Overrides(tt.kvs)
+			if tt.want.err != "" {
+				assert.EqualError(t, err, tt.want.err)
+				return
+			}
+			assert.NoError(t, err)
+
+			cfg, _, err := new(config.Config).Merge(config.Options{}, config.Input{Source: flagSource, Reader: r})
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want.config, cfg)
+		})
+	}
+}
+
+func TestValues(t *testing.T) {
+	t.Parallel()
+
+	cfg := config.Config{
+		View:   config.View{Theme: "nord", EmojiSet: config.EmojiSetDevmoji},
+		Commit: config.Commit{Scopes: []string{"ui", "cmd"}},
+	}
+	srcs := config.Sources{
+		"view.theme":     globalSource,
+		"commit.scopes":  repoSource,
+		"commit.signoff": globalSource,
+		"lint.bodyLimit": repoSource,
+		"lint.blankLine": repoSource,
+	}
+
+	vs, err := config.Values(cfg, srcs)
+	assert.NoError(t, err)
+
+	got := make(map[string]config.Value)
+	for _, v := range vs {
+		got[v.Key] = v
+	}
+
+	assert.Len(t, vs, len(config.Keys()))
+	assert.Equal(t, config.Value{Key: "view.theme", Value: "nord", Source: globalSource}, got["view.theme"])
+	assert.Equal(t, config.Value{Key: "view.emojiSet", Value: "devmoji", Source: config.Source{Layer: config.LayerDefault}}, got["view.emojiSet"])
+	assert.Equal(t, config.Value{Key: "commit.scopes", Value: "[ui, cmd]", Source: repoSource}, got["commit.scopes"])
+	assert.Equal(t, config.Value{Key: "commit.signoff", Value: "false", Source: globalSource}, got["commit.signoff"])
+	assert.Equal(t, config.Value{Key: "lint.bodyLimit", Value: "0", Source: repoSource}, got["lint.bodyLimit"])
+	assert.Equal(t, config.Value{Key: "view.focus", Value: "emoji", Source: config.Source{Layer: config.LayerDefault}}, got["view.focus"])
+	assert.Equal(t, config.Value{Key: "lint.summaryLimit", Value: "50", Source: config.Source{Layer: config.LayerDefault}}, got["lint.summaryLimit"])
+	assert.Equal(t, config.Value{Key: "lint.summaryCapital", Value: "warning", Source: config.Source{Layer: config.LayerDefault}}, got["lint.summaryCapital"])
+	assert.Equal(t, config.Value{Key: "commit.types", Value: "[feat, fix, docs, style, refactor, perf, test, build, ci, chore, revert]", Source: config.Source{Layer: config.LayerDefault}}, got["commit.types"])
+}
+
+func TestGlobalFile(t *testing.T) {
+	tests := []struct {
+		name string
+		xdg  string
+		want string
+	}{
+		{
+			name: "home",
+			want: "$HOME/.config/committed/config.yaml",
+		},
+		{
+			name: "xdg",
+			xdg:  "/xdg",
+			want: "$XDG_CONFIG_HOME/committed/config.yaml",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("XDG_CONFIG_HOME", tt.xdg)
+
+			assert.Equal(t, tt.want, config.GlobalFile())
+		})
+	}
+}
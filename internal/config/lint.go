@@ -0,0 +1,59 @@
+package config
+
+import (
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+type Lint struct {
+	SummaryLength  Severity `yaml:"summaryLength,omitempty"`
+	SummaryPeriod  Severity `yaml:"summaryPeriod,omitempty"`
+	SummaryCapital Severity `yaml:"summaryCapital,omitempty"`
+	BlankLine      Severity `yaml:"blankLine,omitempty"`
+	BodyWidth      Severity `yaml:"bodyWidth,omitempty"`
+	ForbiddenWords Severity `yaml:"forbiddenWords,omitempty"`
+	RequireEmoji   Severity `yaml:"requireEmoji,omitempty"`
+	SummaryLimit   int      `yaml:"summaryLimit,omitempty"`
+	BodyLimit      int      `yaml:"bodyLimit,omitempty"`
+	Words          []string `yaml:"words,omitempty,flow"`
+}
+
+type Severity int
+
+const (
+	SeverityUnset Severity = iota
+	SeverityOff
+	SeverityWarning
+	SeverityError
+)
+
+func (s *Severity) UnmarshalYAML(value *yaml.Node) error {
+	*s = ParseSeverity(value.Value)
+
+	return nil
+}
+
+func (s Severity) MarshalYAML() (interface{}, error) {
+	return s.String(), nil
+}
+
+func (s Severity) String() string {
+	return []string{
+		"",
+		"off",
+		"warning",
+		"error",
+	}[s]
+}
+
+func ParseSeverity(str string) Severity {
+	severity := map[string]Severity{
+		"":        SeverityUnset,
+		"off":     SeverityOff,
+		"warning": SeverityWarning,
+		"error":   SeverityError,
+	}
+
+	return severity[strings.ToLower(str)]
+}
@@ -0,0 +1,66 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/mikelorant/committed/internal/config"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+)
+
+func TestUnmarshallYAMLSeverity(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		want  config.Severity
+	}{
+		{name: "empty", input: "", want: config.SeverityUnset},
+		{name: "off", input: "off", want: config.SeverityOff},
+		{name: "warning", input: "warning", want: config.SeverityWarning},
+		{name: "error", input: "error", want: config.SeverityError},
+		{name: "uppercase", input: "ERROR", want: config.SeverityError},
+		{name: "invalid", input: "invalid", want: config.SeverityUnset},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var got config.Severity
+
+			yaml.Unmarshal([]byte(tt.input), &got)
+			assert.Equal(t, tt.want, got, tt.name)
+		})
+	}
+}
+
+func TestMarshallYAMLSeverity(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input config.Severity
+		want  string
+	}{
+		{name: "empty", input: config.SeverityUnset, want: "\"\"\n"},
+		{name: "off", input: config.SeverityOff, want: "\"off\"\n"},
+		{name: "warning", input: config.SeverityWarning, want: "warning\n"},
+		{name: "error", input: config.SeverityError, want: "error\n"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, _ := yaml.Marshal(&tt.input)
+			assert.Equal(t, tt.want, string(got), tt.name)
+		})
+	}
+}
@@ -0,0 +1,151 @@
+package config
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Version is the version of the config format. Files without a version are
+// version 0.
+const Version = 1
+
+const versionKey = "version"
+
+// migrations upgrade a config one version at a time. The migration at index
+// i upgrades a config from version i to version i+1.
+var migrations = []func(*yaml.Node){
+	migrateCase,
+}
+
+// Migrate upgrades a config file to the current version. Comments and the
+// order of settings are kept. The version of the original file is returned
+// and a file that is already current is returned unchanged.
+func Migrate(src []byte) ([]byte, int, error) {
+	doc, err := decodeDocument(src)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	root := doc.Content[0]
+
+	from, err := migrateNode(root)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if from == Version {
+		return src, from, nil
+	}
+
+	setVersion(root)
+
+	out, err := encodeDocument(doc)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return out, from, nil
+}
+
+// migrateNode upgrades a decoded config to the current version and returns
+// the version it was upgraded from. Configs newer than this release are an
+// error rather than having their settings ignored.
+func migrateNode(n *yaml.Node) (int, error) {
+	v, err := nodeVersion(n)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, m := range migrations[v:] {
+		m(n)
+	}
+
+	return v, nil
+}
+
+// setVersion sets the version to the current version. A missing version is
+// added as the first setting.
+func setVersion(n *yaml.Node) {
+	val := &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!int", Value: strconv.Itoa(Version)}
+
+	if mappingValue(n, versionKey) != nil {
+		setMappingValue(n, versionKey, val)
+
+		return
+	}
+
+	k := &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: versionKey}
+	n.Content = append([]*yaml.Node{k, val}, n.Content...)
+}
+
+func nodeVersion(n *yaml.Node) (int, error) {
+	vn := mappingValue(n, versionKey)
+	if vn == nil {
+		return 0, nil
+	}
+
+	var v int
+
+	if err := vn.Decode(&v); err != nil || v < 0 {
+		return 0, fmt.Errorf("%w: %v", ErrVersion, vn.Value)
+	}
+
+	if v > Version {
+		return 0, fmt.Errorf("%w: %v: newest supported version is %v", ErrVersion, v, Version)
+	}
+
+	return v, nil
+}
+
+// migrateCase corrects the case of keys and enumerated values. Keys are
+// case sensitive so a key such as "emojiset" was silently ignored.
+func migrateCase(n *yaml.Node) {
+	foldNode(n, "")
+}
+
+func foldNode(n *yaml.Node, prefix string) {
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		k, val := n.Content[i], n.Content[i+1]
+
+		if name, ok := foldKey(prefix, k.Value); ok {
+			k.Value = name
+		}
+
+		key := joinKey(prefix, k.Value)
+
+		switch {
+		case hasPrefixKey(key) && val.Kind == yaml.MappingNode:
+			foldNode(val, key)
+		case key == profilesKey && val.Kind == yaml.SequenceNode:
+			for _, item := range val.Content {
+				if item.Kind == yaml.MappingNode {
+					foldNode(item, "")
+				}
+			}
+		case val.Kind == yaml.ScalarNode && Allowed(key) != nil && isAllowed(Allowed(key), val.Value, true):
+			val.Value = strings.ToLower(val.Value)
+		}
+	}
+}
+
+// foldKey returns the name of the key or group within a prefix that matches
+// a name regardless of case.
+func foldKey(prefix, name string) (string, bool) {
+	pfx := joinKey(prefix, "")
+
+	for _, k := range Keys() {
+		if !strings.HasPrefix(k, pfx) {
+			continue
+		}
+
+		seg, _, _ := strings.Cut(strings.TrimPrefix(k, pfx), keySeparator)
+		if strings.EqualFold(seg, name) {
+			return seg, true
+		}
+	}
+
+	return "", false
+}
@@ -0,0 +1,146 @@
+package config_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/mikelorant/committed/internal/config"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMigrate(t *testing.T) {
+	t.Parallel()
+
+	type want struct {
+		out     string
+		version int
+		err     string
+	}
+
+	tests := []struct {
+		name string
+		src  string
+		want want
+	}{
+		{
+			name: "empty",
+			want: want{
+				out: "version: 1\n",
+			},
+		},
+		{
+			name: "current",
+			src:  "version: 1\nview: {emojiSet: DevMoji}\n",
+			want: want{
+				out:     "version: 1\nview: {emojiSet: DevMoji}\n",
+				version: 1,
+			},
+		},
+		{
+			name: "case",
+			src: heredoc.Doc(`
+				# Settings.
+
+				View:
+				  # Emoji set to use.
+				  emojiset: DevMoji
+				  theme: Nord
+				commit:
+				  SignOff: true
+			`),
+			want: want{
+				out: heredoc.Doc(`
+					# Settings.
+
+					version: 1
+					view:
+					  # Emoji set to use.
+					  emojiSet: devmoji
+					  theme: Nord
+					commit:
+					  signoff: true
+				`),
+			},
+		},
+		{
+			name: "profiles",
+			src: heredoc.Doc(`
+				version: 0
+				profiles:
+				  - name: work
+				    View: {EmojiSet: Devmoji}
+			`),
+			want: want{
+				out: heredoc.Doc(`
+					version: 1
+					profiles:
+					  - name: work
+					    view: {emojiSet: devmoji}
+				`),
+			},
+		},
+		{
+			name: "unknown_kept",
+			src:  "view: {unknown: true}\n",
+			want: want{
+				out: "version: 1\nview: {unknown: true}\n",
+			},
+		},
+		{
+			name: "newer",
+			src:  "version: 2\n",
+			want: want{
+				err: "unsupported config version: 2: newest supported version is 1",
+			},
+		},
+		{
+			name: "invalid_version",
+			src:  "version: latest\n",
+			want: want{
+				err: "unsupported config version: latest",
+			},
+		},
+		{
+			name: "not_mapping",
+			src:  "invalid",
+			want: want{
+				err: "config must be a mapping",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			out, v, err := config.Migrate([]byte(tt.src))
+			if tt.want.err != "" {
+				assert.EqualError(t, err, tt.want.err)
+				return
+			}
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.want.out, string(out))
+			assert.Equal(t, tt.want.version, v)
+		})
+	}
+}
+
+func TestMergeMigrates(t *testing.T) {
+	t.Parallel()
+
+	cfg, _, err := new(config.Config).Merge(config.Options{Strict: true},
+		config.Input{Source: globalSource, Reader: strings.NewReader("view: {emojiset: DevMoji}")},
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, config.EmojiSetDevmoji, cfg.View.EmojiSet)
+
+	_, _, err = new(config.Config).Merge(config.Options{},
+		config.Input{Source: globalSource, Reader: strings.NewReader("version: 2")},
+	)
+	assert.EqualError(t, err, "unable to migrate config: global (config.yaml): unsupported config version: 2: newest supported version is 1")
+}
@@ -0,0 +1,177 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/mikelorant/committed/internal/repository"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Profile is a named set of settings that replace the view, commit and
+// author settings when selected.
+type Profile struct {
+	Name    string            `yaml:"name"`
+	Match   Match             `yaml:"match,omitempty"`
+	View    View              `yaml:"view,omitempty"`
+	Commit  Commit            `yaml:"commit,omitempty"`
+	Authors []repository.User `yaml:"authors,omitempty,flow"`
+}
+
+// Match selects a profile automatically. Paths are glob patterns matched
+// against the worktree and its parent directories. Remotes are glob
+// patterns matched against the remote URLs, where "*" matches any text.
+type Match struct {
+	Paths   []string `yaml:"paths,omitempty,flow"`
+	Remotes []string `yaml:"remotes,omitempty,flow"`
+}
+
+const (
+	profilesKey = "profiles"
+	homePrefix  = "~/"
+)
+
+// profileSettings are the groups a profile replaces.
+var profileSettings = map[string]bool{
+	"view":    true,
+	"commit":  true,
+	"authors": true,
+}
+
+// Matches reports if the profile applies to a worktree with the given
+// remote URLs. A profile without rules never matches.
+func (p Profile) Matches(path string, remotes []string) bool {
+	for _, pat := range p.Match.Paths {
+		if matchPath(pat, path) {
+			return true
+		}
+	}
+
+	for _, pat := range p.Match.Remotes {
+		for _, r := range remotes {
+			if matchGlob(pat, r) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// Names returns the name of each profile.
+func Names(ps []Profile) []string {
+	var ns []string
+
+	for _, p := range ps {
+		ns = append(ns, p.Name)
+	}
+
+	return ns
+}
+
+func matchPath(pattern, path string) bool {
+	if path == "" {
+		return false
+	}
+
+	pattern = filepath.Clean(expandHome(os.ExpandEnv(pattern)))
+
+	for dir := filepath.Clean(path); ; dir = filepath.Dir(dir) {
+		if ok, _ := filepath.Match(pattern, dir); ok {
+			return true
+		}
+
+		if dir == filepath.Dir(dir) {
+			return false
+		}
+	}
+}
+
+func matchGlob(pattern, s string) bool {
+	var re strings.Builder
+
+	re.WriteString("^")
+
+	for _, r := range pattern {
+		switch r {
+		case '*':
+			re.WriteString(".*")
+		case '?':
+			re.WriteString(".")
+		default:
+			re.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+
+	re.WriteString("$")
+
+	ok, _ := regexp.MatchString(re.String(), s)
+
+	return ok
+}
+
+func expandHome(path string) string {
+	if !strings.HasPrefix(path, homePrefix) {
+		return path
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return path
+	}
+
+	return filepath.Join(home, strings.TrimPrefix(path, homePrefix))
+}
+
+// applyProfile merges the settings of the selected profile into the root
+// node and returns its name. A profile is selected by name or, when no name
+// is given, by the first profile whose rules match.
+func applyProfile(root *yaml.Node, opts Options, srcs Sources) (string, error) {
+	n := mappingValue(root, profilesKey)
+	if n == nil || n.Kind != yaml.SequenceNode {
+		if opts.Profile != "" {
+			return "", fmt.Errorf("%w: %v", ErrProfile, opts.Profile)
+		}
+
+		return "", nil
+	}
+
+	for _, item := range n.Content {
+		var p Profile
+
+		if err := item.Decode(&p); err != nil {
+			return "", fmt.Errorf("unable to decode profile: %w", err)
+		}
+
+		switch {
+		case opts.Profile != "" && opts.Profile != p.Name:
+			continue
+		case opts.Profile == "" && !p.Matches(opts.Path, opts.Remotes):
+			continue
+		}
+
+		settings := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+
+		for i := 0; i+1 < len(item.Content); i += 2 {
+			k, v := item.Content[i], item.Content[i+1]
+
+			if profileSettings[k.Value] {
+				settings.Content = append(settings.Content, k, v)
+			}
+		}
+
+		mergeNode(root, settings, "", Source{Layer: LayerProfile, Profile: p.Name}, srcs)
+
+		return p.Name, nil
+	}
+
+	if opts.Profile != "" {
+		return "", fmt.Errorf("%w: %v", ErrProfile, opts.Profile)
+	}
+
+	return "", nil
+}
@@ -0,0 +1,282 @@
+package config_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/mikelorant/committed/internal/config"
+	"github.com/mikelorant/committed/internal/repository"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProfileMatches(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		match   config.Match
+		path    string
+		remotes []string
+	}
+
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{
+			name: "no_rules",
+			args: args{
+				path: "/src/project",
+			},
+		},
+		{
+			name: "path",
+			args: args{
+				match: config.Match{Paths: []string{"/src/project"}},
+				path:  "/src/project",
+			},
+			want: true,
+		},
+		{
+			name: "path_parent",
+			args: args{
+				match: config.Match{Paths: []string{"/work"}},
+				path:  "/work/team/project",
+			},
+			want: true,
+		},
+		{
+			name: "path_glob",
+			args: args{
+				match: config.Match{Paths: []string{"/src/acme-*"}},
+				path:  "/src/acme-api",
+			},
+			want: true,
+		},
+		{
+			name: "path_mismatch",
+			args: args{
+				match: config.Match{Paths: []string{"/work"}},
+				path:  "/src/project",
+			},
+		},
+		{
+			name: "path_outside_worktree",
+			args: args{
+				match: config.Match{Paths: []string{"/"}},
+			},
+		},
+		{
+			name: "remote_ssh",
+			args: args{
+				match:   config.Match{Remotes: []string{"*github.com?acme/*"}},
+				remotes: []string{"git@github.com:acme/api.git"},
+			},
+			want: true,
+		},
+		{
+			name: "remote_https",
+			args: args{
+				match:   config.Match{Remotes: []string{"*github.com?acme/*"}},
+				remotes: []string{"https://github.com/other/api.git", "https://github.com/acme/api.git"},
+			},
+			want: true,
+		},
+		{
+			name: "remote_mismatch",
+			args: args{
+				match:   config.Match{Remotes: []string{"*github.com?acme/*"}},
+				remotes: []string{"https://gitlab.com/acme/api.git"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			p := config.Profile{Match: tt.args.match}
+
+			assert.Equal(t, tt.want, p.Matches(tt.args.path, tt.args.remotes))
+		})
+	}
+}
+
+func TestMergeProfile(t *testing.T) {
+	t.Parallel()
+
+	global := heredoc.Doc(`
+		view:
+		  emojiSet: gitmoji
+		  theme: nord
+		commit:
+		  signoff: true
+		profiles:
+		  - name: work
+		    match:
+		      remotes: ["*github.com?acme/*"]
+		    view:
+		      emojiSet: devmoji
+		    commit:
+		      signoff: false
+		    authors:
+		      - name: John Doe
+		        email: john.doe@acme.com
+		  - name: oss
+		    match:
+		      paths: [/src/oss]
+		    commit:
+		      signoff: true
+	`)
+
+	type args struct {
+		opts config.Options
+		env  string
+		flag string
+	}
+
+	type want struct {
+		profile  string
+		emojiSet config.EmojiSet
+		signoff  bool
+		authors  []repository.User
+		sources  map[string]config.Source
+		err      string
+	}
+
+	profileSource := func(name string) config.Source {
+		return config.Source{Layer: config.LayerProfile, Profile: name}
+	}
+
+	tests := []struct {
+		name string
+		args args
+		want want
+	}{
+		{
+			name: "none",
+			want: want{
+				emojiSet: config.EmojiSetGitmoji,
+				signoff:  true,
+				sources: map[string]config.Source{
+					"commit.signoff": globalSource,
+				},
+			},
+		},
+		{
+			name: "named",
+			args: args{
+				opts: config.Options{Profile: "work"},
+			},
+			want: want{
+				profile:  "work",
+				emojiSet: config.EmojiSetDevmoji,
+				authors:  []repository.User{{Name: "John Doe", Email: "john.doe@acme.com"}},
+				sources: map[string]config.Source{
+					"view.emojiSet":  profileSource("work"),
+					"view.theme":     globalSource,
+					"commit.signoff": profileSource("work"),
+					"authors":        profileSource("work"),
+				},
+			},
+		},
+		{
+			name: "match_remote",
+			args: args{
+				opts: config.Options{Path: "/src/api", Remotes: []string{"git@github.com:acme/api.git"}},
+			},
+			want: want{
+				profile:  "work",
+				emojiSet: config.EmojiSetDevmoji,
+				authors:  []repository.User{{Name: "John Doe", Email: "john.doe@acme.com"}},
+			},
+		},
+		{
+			name: "match_path",
+			args: args{
+				opts: config.Options{Path: "/src/oss/project"},
+			},
+			want: want{
+				profile:  "oss",
+				emojiSet: config.EmojiSetGitmoji,
+				signoff:  true,
+				sources: map[string]config.Source{
+					"commit.signoff": profileSource("oss"),
+				},
+			},
+		},
+		{
+			name: "flag_overrides_profile",
+			args: args{
+				opts: config.Options{Profile: "work"},
+				flag: "commit: {signoff: true}",
+			},
+			want: want{
+				profile:  "work",
+				emojiSet: config.EmojiSetDevmoji,
+				signoff:  true,
+				authors:  []repository.User{{Name: "John Doe", Email: "john.doe@acme.com"}},
+				sources: map[string]config.Source{
+					"commit.signoff": flagSource,
+				},
+			},
+		},
+		{
+			name: "environment_overrides_profile",
+			args: args{
+				opts: config.Options{Profile: "work"},
+				env:  "view: {emojiSet: emojilog}",
+			},
+			want: want{
+				profile:  "work",
+				emojiSet: config.EmojiSetEmojiLog,
+				authors:  []repository.User{{Name: "John Doe", Email: "john.doe@acme.com"}},
+				sources: map[string]config.Source{
+					"view.emojiSet":  {Layer: config.LayerEnvironment},
+					"commit.signoff": profileSource("work"),
+				},
+			},
+		},
+		{
+			name: "unknown",
+			args: args{
+				opts: config.Options{Profile: "home"},
+			},
+			want: want{
+				err: "unknown profile: home",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg, srcs, err := new(config.Config).Merge(tt.args.opts,
+				config.Input{Source: globalSource, Reader: strings.NewReader(global)},
+				config.Input{Source: config.Source{Layer: config.LayerEnvironment}, Reader: strings.NewReader(tt.args.env)},
+				config.Input{Source: flagSource, Reader: strings.NewReader(tt.args.flag)},
+			)
+			if tt.want.err != "" {
+				assert.EqualError(t, err, tt.want.err)
+				return
+			}
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.want.profile, cfg.Profile)
+			assert.Equal(t, tt.want.emojiSet, cfg.View.EmojiSet)
+			assert.Equal(t, tt.want.signoff, cfg.Commit.Signoff)
+			assert.Equal(t, tt.want.authors, cfg.Authors)
+
+			for k, v := range tt.want.sources {
+				assert.Equal(t, v, srcs.Get(k), k)
+			}
+		})
+	}
+}
@@ -0,0 +1,356 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ProjectFiles are the config files of other commit tools that are read
+// from the repository root, in the order they are applied. JSON is a subset
+// of YAML so every file is decoded as YAML.
+var ProjectFiles = []string{
+	".gitmojirc.json",
+	".commitlintrc",
+	".commitlintrc.json",
+	".commitlintrc.yaml",
+	".commitlintrc.yml",
+	".cz.json",
+}
+
+type gitmojiConfig struct {
+	EmojiFormat string   `yaml:"emojiFormat"`
+	Scopes      []string `yaml:"scopes"`
+}
+
+type commitlintConfig struct {
+	Extends yaml.Node            `yaml:"extends"`
+	Rules   map[string]yaml.Node `yaml:"rules"`
+}
+
+type commitizenConfig struct {
+	Path       string            `yaml:"path"`
+	Name       string            `yaml:"name"`
+	Types      []commitizenItem  `yaml:"types"`
+	Scopes     []commitizenItem  `yaml:"scopes"`
+	Commitizen *commitizenConfig `yaml:"commitizen"`
+}
+
+// commitizenItem is a type or scope, given either as a string or as a
+// mapping with a value or name.
+type commitizenItem string
+
+// ProjectOverrides converts the settings of another commit tool into
+// "key=value" overrides. The tool is chosen by the name of the file:
+//
+//   - .gitmojirc.json sets the emoji type from the emoji format and the
+//     scopes.
+//   - .commitlintrc sets the types, scopes and the summary and body limits
+//     from the type-enum, scope-enum, header-max-length and
+//     body-max-line-length rules. Rule levels set the lint severities.
+//   - .cz.json sets the types and scopes.
+//
+// Extending a conventional preset, such as @commitlint/config-conventional,
+// selects the conventional style. Settings that cannot be represented are
+// ignored.
+func ProjectOverrides(file string, src []byte) ([]string, error) {
+	name := filepath.Base(file)
+
+	var (
+		kvs []string
+		err error
+	)
+
+	switch {
+	case name == ".gitmojirc.json":
+		kvs, err = gitmojiOverrides(src)
+	case strings.HasPrefix(name, ".commitlintrc"):
+		kvs, err = commitlintOverrides(src)
+	case name == ".cz.json":
+		kvs, err = commitizenOverrides(src)
+	default:
+		return nil, fmt.Errorf("%w: unsupported file: %v", ErrProject, name)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v: %v", ErrProject, name, err)
+	}
+
+	return kvs, nil
+}
+
+func gitmojiOverrides(src []byte) ([]string, error) {
+	var cfg gitmojiConfig
+
+	if err := yaml.Unmarshal(src, &cfg); err != nil {
+		return nil, err
+	}
+
+	var kvs []string
+
+	switch cfg.EmojiFormat {
+	case "":
+	case "code":
+		kvs = append(kvs, "commit.emojiType=shortcode")
+	case "emoji":
+		kvs = append(kvs, "commit.emojiType=character")
+	default:
+		return nil, fmt.Errorf("emojiFormat: unknown format: %v", cfg.EmojiFormat)
+	}
+
+	if len(cfg.Scopes) > 0 {
+		v, err := flowList(cfg.Scopes)
+		if err != nil {
+			return nil, err
+		}
+
+		kvs = append(kvs, "commit.scopes="+v)
+	}
+
+	return kvs, nil
+}
+
+func commitlintOverrides(src []byte) ([]string, error) {
+	var cfg commitlintConfig
+
+	if err := yaml.Unmarshal(src, &cfg); err != nil {
+		return nil, err
+	}
+
+	var (
+		kvs     []string
+		extends []string
+	)
+
+	switch cfg.Extends.Kind {
+	case yaml.ScalarNode:
+		extends = []string{cfg.Extends.Value}
+	case yaml.SequenceNode:
+		if err := cfg.Extends.Decode(&extends); err != nil {
+			return nil, fmt.Errorf("extends: %w", err)
+		}
+	}
+
+	if conventional(extends...) {
+		kvs = append(kvs, "commit.style=conventional")
+	}
+
+	lists := []struct {
+		rule string
+		key  string
+	}{
+		{"type-enum", "commit.types"},
+		{"scope-enum", "commit.scopes"},
+	}
+
+	for _, l := range lists {
+		n, ok := cfg.Rules[l.rule]
+		if !ok {
+			continue
+		}
+
+		r, err := parseRule(n)
+		if err != nil {
+			return nil, fmt.Errorf("%v: %w", l.rule, err)
+		}
+
+		var vs []string
+
+		if !r.apply() || r.value == nil || r.value.Decode(&vs) != nil || len(vs) == 0 {
+			continue
+		}
+
+		v, err := flowList(vs)
+		if err != nil {
+			return nil, err
+		}
+
+		kvs = append(kvs, l.key+"="+v)
+	}
+
+	limits := []struct {
+		rule     string
+		severity string
+		limit    string
+	}{
+		{"header-max-length", "lint.summaryLength", "lint.summaryLimit"},
+		{"body-max-line-length", "lint.bodyWidth", "lint.bodyLimit"},
+	}
+
+	for _, l := range limits {
+		n, ok := cfg.Rules[l.rule]
+		if !ok {
+			continue
+		}
+
+		r, err := parseRule(n)
+		if err != nil {
+			return nil, fmt.Errorf("%v: %w", l.rule, err)
+		}
+
+		if r.never {
+			continue
+		}
+
+		kvs = append(kvs, l.severity+"="+r.severity.String())
+
+		var limit int
+
+		if r.value == nil || r.value.Decode(&limit) != nil || limit <= 0 {
+			continue
+		}
+
+		kvs = append(kvs, l.limit+"="+strconv.Itoa(limit))
+	}
+
+	return kvs, nil
+}
+
+func commitizenOverrides(src []byte) ([]string, error) {
+	var cfg commitizenConfig
+
+	if err := yaml.Unmarshal(src, &cfg); err != nil {
+		return nil, err
+	}
+
+	// Commitizen for Python nests its settings.
+	if cfg.Commitizen != nil {
+		cfg = *cfg.Commitizen
+	}
+
+	var kvs []string
+
+	if conventional(cfg.Path, cfg.Name) {
+		kvs = append(kvs, "commit.style=conventional")
+	}
+
+	lists := []struct {
+		items []commitizenItem
+		key   string
+	}{
+		{cfg.Types, "commit.types"},
+		{cfg.Scopes, "commit.scopes"},
+	}
+
+	for _, l := range lists {
+		var vs []string
+
+		for _, i := range l.items {
+			if i != "" {
+				vs = append(vs, string(i))
+			}
+		}
+
+		if len(vs) == 0 {
+			continue
+		}
+
+		v, err := flowList(vs)
+		if err != nil {
+			return nil, err
+		}
+
+		kvs = append(kvs, l.key+"="+v)
+	}
+
+	return kvs, nil
+}
+
+func (i *commitizenItem) UnmarshalYAML(value *yaml.Node) error {
+	if value.Kind == yaml.ScalarNode {
+		*i = commitizenItem(value.Value)
+
+		return nil
+	}
+
+	for _, k := range []string{"value", "name"} {
+		if v := mappingValue(value, k); v != nil && v.Kind == yaml.ScalarNode {
+			*i = commitizenItem(v.Value)
+
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// commitlintRule is a rule given as [level, applicable, value]. Level 0
+// disables the rule, 1 is a warning and 2 an error.
+type commitlintRule struct {
+	severity Severity
+	never    bool
+	value    *yaml.Node
+}
+
+func parseRule(n yaml.Node) (commitlintRule, error) {
+	var r commitlintRule
+
+	if n.Kind != yaml.SequenceNode || len(n.Content) == 0 {
+		return r, errors.New("expected a list")
+	}
+
+	var level int
+
+	if err := n.Content[0].Decode(&level); err != nil {
+		return r, fmt.Errorf("invalid level: %v", n.Content[0].Value)
+	}
+
+	switch level {
+	case 0:
+		r.severity = SeverityOff
+	case 1:
+		r.severity = SeverityWarning
+	case 2:
+		r.severity = SeverityError
+	default:
+		return r, fmt.Errorf("invalid level: %v", level)
+	}
+
+	if len(n.Content) > 1 {
+		r.never = n.Content[1].Value == "never"
+	}
+
+	if len(n.Content) > 2 {
+		r.value = n.Content[2]
+	}
+
+	return r, nil
+}
+
+// apply reports if an enum rule restricts the allowed values.
+func (r commitlintRule) apply() bool {
+	return r.severity != SeverityOff && !r.never
+}
+
+// conventional reports if any preset or adapter follows Conventional
+// Commits.
+func conventional(names ...string) bool {
+	for _, n := range names {
+		if strings.Contains(n, "conventional") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// flowList encodes values as a YAML flow sequence so they can be parsed as
+// an override.
+func flowList(vs []string) (string, error) {
+	n := &yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq"}
+
+	for _, v := range vs {
+		n.Content = append(n.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: v})
+	}
+
+	out, err := nodeString(n)
+	if err != nil {
+		return "", fmt.Errorf("unable to encode list: %w", err)
+	}
+
+	return out, nil
+}
@@ -0,0 +1,201 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/mikelorant/committed/internal/config"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProjectOverrides(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		file string
+		src  string
+	}
+
+	type want struct {
+		kvs []string
+		err string
+	}
+
+	tests := []struct {
+		name string
+		args args
+		want want
+	}{
+		{
+			name: "gitmoji_code",
+			args: args{
+				file: "/repo/.gitmojirc.json",
+				src:  `{"emojiFormat": "code", "scopes": ["api", "ui"], "autoAdd": false}`,
+			},
+			want: want{
+				kvs: []string{
+					"commit.emojiType=shortcode",
+					"commit.scopes=[api, ui]",
+				},
+			},
+		},
+		{
+			name: "gitmoji_emoji",
+			args: args{
+				file: ".gitmojirc.json",
+				src:  `{"emojiFormat": "emoji"}`,
+			},
+			want: want{
+				kvs: []string{"commit.emojiType=character"},
+			},
+		},
+		{
+			name: "gitmoji_invalid_format",
+			args: args{
+				file: ".gitmojirc.json",
+				src:  `{"emojiFormat": "unicode"}`,
+			},
+			want: want{
+				err: "invalid project config: .gitmojirc.json: emojiFormat: unknown format: unicode",
+			},
+		},
+		{
+			name: "commitlint_json",
+			args: args{
+				file: ".commitlintrc.json",
+				src: heredoc.Doc(`
+					{
+					  "extends": ["@commitlint/config-conventional"],
+					  "rules": {
+					    "type-enum": [2, "always", ["feat", "fix"]],
+					    "scope-enum": [1, "always", ["api", "ui: web"]],
+					    "header-max-length": [2, "always", 72],
+					    "body-max-line-length": [1, "always", 100]
+					  }
+					}
+				`),
+			},
+			want: want{
+				kvs: []string{
+					"commit.style=conventional",
+					"commit.types=[feat, fix]",
+					"commit.scopes=[api, 'ui: web']",
+					"lint.summaryLength=error",
+					"lint.summaryLimit=72",
+					"lint.bodyWidth=warning",
+					"lint.bodyLimit=100",
+				},
+			},
+		},
+		{
+			name: "commitlint_yaml",
+			args: args{
+				file: ".commitlintrc.yaml",
+				src: heredoc.Doc(`
+					extends: "@commitlint/config-angular"
+					rules:
+					  type-enum: [0, always, [feat]]
+					  scope-enum: [2, never, [deps]]
+					  body-max-line-length: [0, always, 100]
+					  header-max-length: [2, never, 50]
+				`),
+			},
+			want: want{
+				kvs: []string{
+					"lint.bodyWidth=off",
+					"lint.bodyLimit=100",
+				},
+			},
+		},
+		{
+			name: "commitlint_invalid_level",
+			args: args{
+				file: ".commitlintrc",
+				src:  `{"rules": {"header-max-length": [3, "always", 72]}}`,
+			},
+			want: want{
+				err: "invalid project config: .commitlintrc: header-max-length: invalid level: 3",
+			},
+		},
+		{
+			name: "commitlint_invalid_rule",
+			args: args{
+				file: ".commitlintrc.yml",
+				src:  `{"rules": {"type-enum": "feat"}}`,
+			},
+			want: want{
+				err: "invalid project config: .commitlintrc.yml: type-enum: expected a list",
+			},
+		},
+		{
+			name: "commitizen",
+			args: args{
+				file: ".cz.json",
+				src: heredoc.Doc(`
+					{
+					  "path": "cz-conventional-changelog",
+					  "types": [{"value": "feat", "name": "feat: A new feature"}, "fix"],
+					  "scopes": [{"name": "api"}]
+					}
+				`),
+			},
+			want: want{
+				kvs: []string{
+					"commit.style=conventional",
+					"commit.types=[feat, fix]",
+					"commit.scopes=[api]",
+				},
+			},
+		},
+		{
+			name: "commitizen_python",
+			args: args{
+				file: ".cz.json",
+				src:  `{"commitizen": {"name": "cz_conventional_commits", "version": "1.0.0"}}`,
+			},
+			want: want{
+				kvs: []string{"commit.style=conventional"},
+			},
+		},
+		{
+			name: "decode_error",
+			args: args{
+				file: ".cz.json",
+				src:  `{"types": {}`,
+			},
+			want: want{
+				err: "invalid project config: .cz.json: yaml: line 1: did not find expected ',' or '}'",
+			},
+		},
+		{
+			name: "unsupported",
+			args: args{
+				file: "commitlint.config.js",
+			},
+			want: want{
+				err: "invalid project config: unsupported file: commitlint.config.js",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			kvs, err := config.ProjectOverrides(tt.args.file, []byte(tt.args.src))
+			if tt.want.err != "" {
+				assert.EqualError(t, err, tt.want.err)
+				return
+			}
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.want.kvs, kvs)
+
+			_, err = config.Overrides(kvs)
+			assert.NoError(t, err)
+		})
+	}
+}
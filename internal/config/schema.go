@@ -0,0 +1,184 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// schema is the subset of JSON Schema used to describe a config file.
+type schema struct {
+	Schema               string             `json:"$schema,omitempty"`
+	Title                string             `json:"title,omitempty"`
+	Description          string             `json:"description,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Enum                 []string           `json:"enum,omitempty"`
+	Examples             []string           `json:"examples,omitempty"`
+	Maximum              *int               `json:"maximum,omitempty"`
+	Items                *schema            `json:"items,omitempty"`
+	Properties           map[string]*schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	AdditionalProperties *bool              `json:"additionalProperties,omitempty"`
+}
+
+const (
+	schemaDraft = "https://json-schema.org/draft/2020-12/schema"
+	schemaTitle = "Committed configuration"
+)
+
+// Schema returns a JSON Schema describing a config file so that editors can
+// complete and validate settings. Descriptions are taken from the comments
+// of the default config file. The theme IDs of the options are given as
+// examples as custom themes may be named in the config.
+func Schema(opts Options) ([]byte, error) {
+	var doc yaml.Node
+
+	if err := yaml.Unmarshal([]byte(DefaultFile), &doc); err != nil {
+		return nil, fmt.Errorf("unable to decode default config: %w", err)
+	}
+
+	sb := schemaBuilder{doc: &doc, opts: opts}
+
+	s := sb.build(reflect.TypeOf(Config{}), "")
+	s.Schema = schemaDraft
+	s.Title = schemaTitle
+
+	out, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("unable to encode schema: %w", err)
+	}
+
+	return append(out, '\n'), nil
+}
+
+type schemaBuilder struct {
+	doc  *yaml.Node
+	opts Options
+}
+
+func (sb schemaBuilder) build(t reflect.Type, key string) *schema {
+	s := &schema{Description: sb.description(key)}
+
+	switch {
+	case key == versionKey:
+		v := Version
+		s.Type = "integer"
+		s.Maximum = &v
+	case key == themeKey:
+		// Custom themes are named in the config so any name is valid.
+		s.Type = "string"
+		s.Examples = sb.opts.Themes
+	case key == emojiSetKey:
+		// Custom emoji sets are named in the config so any name is valid.
+		s.Type = "string"
+		s.Examples = append(enums[t][:len(enums[t]):len(enums[t])], sb.opts.EmojiSets...)
+	case key == keysKey:
+		// Each action is bound to a list of keys.
+		s.Type = "object"
+
+		if len(sb.opts.Actions) > 0 {
+			s.Properties = make(map[string]*schema)
+			s.AdditionalProperties = new(bool)
+
+			for _, a := range sb.opts.Actions {
+				s.Properties[a] = sb.build(t.Elem(), "")
+			}
+		}
+	case enums[t] != nil:
+		s.Type = "string"
+		s.Enum = enums[t]
+	case t.Kind() == reflect.Bool:
+		s.Type = "boolean"
+	case t.Kind() == reflect.Int:
+		s.Type = "integer"
+	case t.Kind() == reflect.Slice:
+		s.Type = "array"
+		// Items within a list are described by their own keys.
+		s.Items = sb.build(t.Elem(), "")
+	case t.Kind() == reflect.Struct:
+		s.Type = "object"
+		s.Properties = make(map[string]*schema)
+		s.AdditionalProperties = new(bool)
+
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+
+			name, flags, _ := strings.Cut(f.Tag.Get("yaml"), ",")
+			if name == "" || name == "-" {
+				continue
+			}
+
+			s.Properties[name] = sb.build(f.Type, joinKey(key, name))
+
+			if !strings.Contains(flags, "omitempty") {
+				s.Required = append(s.Required, name)
+			}
+		}
+	default:
+		s.Type = "string"
+	}
+
+	return s
+}
+
+// description returns the comment of a key in the default config file up
+// to the allowed values or an example, which the schema describes itself.
+func (sb schemaBuilder) description(key string) string {
+	if key == "" {
+		return ""
+	}
+
+	k := lookupKeyNode(sb.doc, key)
+	if k == nil {
+		return ""
+	}
+
+	var ls []string
+
+	for _, l := range strings.Split(k.HeadComment, "\n") {
+		l = strings.TrimSpace(strings.TrimPrefix(l, "#"))
+
+		if strings.HasPrefix(l, "Values:") {
+			continue
+		}
+
+		if strings.HasPrefix(l, "Example:") {
+			break
+		}
+
+		if strings.HasPrefix(l, "Default:") {
+			l = "\n" + l
+		}
+
+		ls = append(ls, l)
+	}
+
+	return strings.TrimSpace(strings.ReplaceAll(strings.Join(ls, " "), " \n", "\n"))
+}
+
+// lookupKeyNode returns the key node of a dotted key, which holds the
+// comments of the setting.
+func lookupKeyNode(n *yaml.Node, key string) *yaml.Node {
+	if n.Kind == yaml.DocumentNode && len(n.Content) > 0 {
+		n = n.Content[0]
+	}
+
+	name, rest, nested := strings.Cut(key, keySeparator)
+
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		if n.Content[i].Value != name {
+			continue
+		}
+
+		if !nested {
+			return n.Content[i]
+		}
+
+		return lookupKeyNode(n.Content[i+1], rest)
+	}
+
+	return nil
+}
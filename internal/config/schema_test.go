@@ -0,0 +1,112 @@
+package config_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/mikelorant/committed/internal/config"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type schema struct {
+	Description          string             `json:"description"`
+	Type                 string             `json:"type"`
+	Enum                 []string           `json:"enum"`
+	Examples             []string           `json:"examples"`
+	Maximum              *int               `json:"maximum"`
+	Items                *schema            `json:"items"`
+	Properties           map[string]*schema `json:"properties"`
+	Required             []string           `json:"required"`
+	AdditionalProperties *bool              `json:"additionalProperties"`
+}
+
+func (s *schema) property(key string) *schema {
+	for _, k := range strings.Split(key, ".") {
+		if s == nil {
+			return nil
+		}
+
+		s = s.Properties[k]
+	}
+
+	return s
+}
+
+func TestSchema(t *testing.T) {
+	t.Parallel()
+
+	out, err := config.Schema(config.Options{
+		Themes:    []string{"builtin_dark", "nord"},
+		EmojiSets: []string{"team"},
+		Actions:   []string{"commit", "help"},
+	})
+	assert.NoError(t, err)
+
+	var s schema
+	assert.NoError(t, json.Unmarshal(out, &s))
+
+	for _, k := range config.Keys() {
+		assert.NotNil(t, s.property(k), k)
+	}
+
+	assert.Equal(t, "object", s.Type)
+	assert.False(t, *s.AdditionalProperties)
+
+	version := s.property("version")
+	assert.Equal(t, "integer", version.Type)
+	assert.Equal(t, config.Version, *version.Maximum)
+
+	emojiSet := s.property("view.emojiSet")
+	assert.Equal(t, "string", emojiSet.Type)
+	assert.Nil(t, emojiSet.Enum)
+	assert.Equal(t, []string{"gitmoji", "devmoji", "emojilog", "team"}, emojiSet.Examples)
+	assert.Equal(t, "Emoji set to use. Custom sets are named in emojiSets.\nDefault: gitmoji", emojiSet.Description)
+
+	assert.Nil(t, s.property("view.theme").Enum)
+	assert.Equal(t, []string{"builtin_dark", "nord"}, s.property("view.theme").Examples)
+	assert.Equal(t, "boolean", s.property("commit.signoff").Type)
+	assert.Equal(t, "integer", s.property("lint.bodyLimit").Type)
+
+	scopes := s.property("commit.scopes")
+	assert.Equal(t, "array", scopes.Type)
+	assert.Equal(t, "string", scopes.Items.Type)
+
+	profile := s.property("profiles").Items
+	assert.Equal(t, []string{"name"}, profile.Required)
+	assert.Equal(t, emojiSet, profile.property("view.emojiSet"))
+	assert.Equal(t, "array", profile.property("match.remotes").Type)
+
+	set := s.property("emojiSets").Items
+	assert.Equal(t, []string{"name"}, set.Required)
+	assert.Equal(t, []string{"gitmoji", "devmoji", "emojilog"}, set.property("extends").Enum)
+
+	th := s.property("themes").Items
+	assert.Equal(t, []string{"name"}, th.Required)
+	assert.Equal(t, []string{"adaptive", "dark", "light"}, th.property("colour").Enum)
+	assert.Equal(t, "string", th.property("palette.brightBlack").Type)
+
+	author := s.property("authors").Items
+	assert.Equal(t, "string", author.property("email").Type)
+
+	keys := s.property("keys")
+	assert.Equal(t, "object", keys.Type)
+	assert.False(t, *keys.AdditionalProperties)
+	assert.Len(t, keys.Properties, 2)
+	assert.Equal(t, "array", keys.property("commit").Type)
+	assert.Equal(t, "string", keys.property("help").Items.Type)
+}
+
+func TestSchemaAnyTheme(t *testing.T) {
+	t.Parallel()
+
+	out, err := config.Schema(config.Options{})
+	assert.NoError(t, err)
+
+	var s schema
+	assert.NoError(t, json.Unmarshal(out, &s))
+
+	assert.Nil(t, s.property("view.theme").Enum)
+	assert.Nil(t, s.property("keys").Properties)
+}
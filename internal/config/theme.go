@@ -0,0 +1,203 @@
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// CustomTheme is a theme named in the config. The palette is generated from
+// an accent colour or taken from the built-in theme of the background, and
+// any colour of the palette replaces the generated colour. A custom theme
+// with the same name as a built-in theme changes its colours.
+type CustomTheme struct {
+	Name    string  `yaml:"name"`
+	Colour  Colour  `yaml:"colour,omitempty"`
+	Accent  string  `yaml:"accent,omitempty"`
+	Palette Palette `yaml:"palette,omitempty"`
+}
+
+// Palette is the background, foreground and 16 ANSI colours of a theme as
+// hex colours such as "#1e1e2e".
+type Palette struct {
+	Background   string `yaml:"background,omitempty"`
+	Foreground   string `yaml:"foreground,omitempty"`
+	Black        string `yaml:"black,omitempty"`
+	Red          string `yaml:"red,omitempty"`
+	Green        string `yaml:"green,omitempty"`
+	Yellow       string `yaml:"yellow,omitempty"`
+	Blue         string `yaml:"blue,omitempty"`
+	Purple       string `yaml:"purple,omitempty"`
+	Cyan         string `yaml:"cyan,omitempty"`
+	White        string `yaml:"white,omitempty"`
+	BrightBlack  string `yaml:"brightBlack,omitempty"`
+	BrightRed    string `yaml:"brightRed,omitempty"`
+	BrightGreen  string `yaml:"brightGreen,omitempty"`
+	BrightYellow string `yaml:"brightYellow,omitempty"`
+	BrightBlue   string `yaml:"brightBlue,omitempty"`
+	BrightPurple string `yaml:"brightPurple,omitempty"`
+	BrightCyan   string `yaml:"brightCyan,omitempty"`
+	BrightWhite  string `yaml:"brightWhite,omitempty"`
+}
+
+const (
+	themesKey = "themes"
+	colourKey = "view.colour"
+)
+
+var hexColour = regexp.MustCompile(`^#([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`)
+
+// Dark reports if the theme is shown on dark backgrounds.
+func (t CustomTheme) Dark() bool {
+	return t.Colour != ColourLight
+}
+
+// Light reports if the theme is shown on light backgrounds.
+func (t CustomTheme) Light() bool {
+	return t.Colour != ColourDark
+}
+
+// ThemeNames returns the names of the custom themes.
+func ThemeNames(ts []CustomTheme) []string {
+	ns := make([]string, len(ts))
+
+	for i, t := range ts {
+		ns[i] = t.Name
+	}
+
+	return ns
+}
+
+// declaredThemes returns the names of the custom themes within a decoded
+// config.
+func declaredThemes(n *yaml.Node) []string {
+	val := mappingValue(n, themesKey)
+	if val == nil || val.Kind != yaml.SequenceNode {
+		return nil
+	}
+
+	var ns []string
+
+	for _, item := range val.Content {
+		name := mappingValue(item, "name")
+		if name == nil || name.Kind != yaml.ScalarNode || name.Value == "" {
+			continue
+		}
+
+		ns = append(ns, name.Value)
+	}
+
+	return ns
+}
+
+// themes validates each custom theme. Problems are reported with the theme
+// name, such as "themes.acme.accent".
+func (v validator) themes(n *yaml.Node) []Problem {
+	var ps []Problem
+
+	for i, item := range n.Content {
+		label := fmt.Sprintf("%s.%d", themesKey, i)
+		if name := mappingValue(item, "name"); name != nil && name.Value != "" {
+			label = fmt.Sprintf("%s.%s", themesKey, name.Value)
+		}
+
+		if item.Kind != yaml.MappingNode {
+			ps = append(ps, v.problem(label, item, "expected a mapping"))
+
+			continue
+		}
+
+		tv := validator{file: v.file, opts: v.opts, label: label + keySeparator}
+
+		if mappingValue(item, "name") == nil {
+			ps = append(ps, Problem{
+				File:    v.file,
+				Line:    item.Line,
+				Column:  item.Column,
+				Key:     v.label + label,
+				Message: "missing name",
+			})
+		}
+
+		for j := 0; j+1 < len(item.Content); j += 2 {
+			k, val := item.Content[j], item.Content[j+1]
+
+			switch k.Value {
+			case "name":
+				if val.Kind != yaml.ScalarNode || val.Value == "" {
+					ps = append(ps, tv.problem(k.Value, val, "invalid value, expected a name"))
+				}
+			case "colour":
+				vs := Allowed(colourKey)
+				if val.Kind != yaml.ScalarNode || !isAllowed(vs, val.Value, true) {
+					p := tv.problem(k.Value, val, fmt.Sprintf("invalid value, allowed values: %s", strings.Join(vs, ", ")))
+					p.Allowed = vs
+					ps = append(ps, p)
+				}
+			case "accent":
+				if p, ok := tv.colour(k.Value, val); !ok {
+					ps = append(ps, p)
+				}
+			case "palette":
+				ps = append(ps, tv.palette(k.Value, val)...)
+			default:
+				if v.opts.Strict {
+					ps = append(ps, tv.unknown("", k))
+				}
+			}
+		}
+	}
+
+	return ps
+}
+
+// palette validates the colours of a theme palette.
+func (v validator) palette(key string, n *yaml.Node) []Problem {
+	if n.Kind != yaml.MappingNode {
+		return []Problem{v.problem(key, n, "expected a mapping")}
+	}
+
+	var ps []Problem
+
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		k, val := n.Content[i], n.Content[i+1]
+
+		if !paletteColours[k.Value] {
+			if v.opts.Strict {
+				ps = append(ps, v.unknown(key, k))
+			}
+
+			continue
+		}
+
+		if p, ok := v.colour(joinKey(key, k.Value), val); !ok {
+			ps = append(ps, p)
+		}
+	}
+
+	return ps
+}
+
+func (v validator) colour(key string, n *yaml.Node) (Problem, bool) {
+	if n.Kind == yaml.ScalarNode && hexColour.MatchString(n.Value) {
+		return Problem{}, true
+	}
+
+	return v.problem(key, n, "invalid value, expected a hex colour such as #0a84ff"), false
+}
+
+// paletteColours are the names of the colours of a palette.
+var paletteColours = func() map[string]bool {
+	cs := make(map[string]bool)
+
+	t := reflect.TypeOf(Palette{})
+	for i := 0; i < t.NumField(); i++ {
+		name, _, _ := strings.Cut(t.Field(i).Tag.Get("yaml"), ",")
+		cs[name] = true
+	}
+
+	return cs
+}()
@@ -0,0 +1,308 @@
+package config
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Options control how a config is validated and which profile is applied.
+type Options struct {
+	// Strict rejects unknown keys.
+	Strict bool
+
+	// Themes are the valid theme IDs. Any theme is accepted when empty.
+	// Custom themes declared in the config are valid in addition.
+	Themes []string
+
+	// EmojiSets are the names of custom emoji sets, which are valid in
+	// addition to the built-in sets.
+	EmojiSets []string
+
+	// Actions are the actions that can be bound to keys. Any action is
+	// accepted when empty.
+	Actions []string
+
+	// Profile selects a profile by name.
+	Profile string
+
+	// Path and Remotes describe the worktree for matching profiles when no
+	// profile is named.
+	Path    string
+	Remotes []string
+}
+
+// Problem is an invalid setting within a config file.
+type Problem struct {
+	File    string
+	Line    int
+	Column  int
+	Key     string
+	Value   string
+	Allowed []string
+	Message string
+}
+
+// ValidationError lists the problems found within a config.
+type ValidationError struct {
+	Problems []Problem
+}
+
+type validator struct {
+	file  string
+	opts  Options
+	label string
+}
+
+const themeKey = "view.theme"
+
+func (p Problem) String() string {
+	var pos []string
+
+	if p.File != "" {
+		pos = append(pos, p.File)
+	}
+
+	if p.Line > 0 {
+		pos = append(pos, strconv.Itoa(p.Line), strconv.Itoa(p.Column))
+	}
+
+	if len(pos) == 0 {
+		return fmt.Sprintf("%s: %s", p.Key, p.Message)
+	}
+
+	return fmt.Sprintf("%s: %s: %s", strings.Join(pos, ":"), p.Key, p.Message)
+}
+
+func (p Problem) Error() string {
+	return p.String()
+}
+
+func (e *ValidationError) Error() string {
+	ps := make([]string, len(e.Problems))
+
+	for i, p := range e.Problems {
+		ps[i] = p.String()
+	}
+
+	return fmt.Sprintf("invalid config: %s", strings.Join(ps, "; "))
+}
+
+// Validate checks a config for values that are not valid for their setting
+// and, when strict, unknown keys.
+func Validate(r io.Reader, file string, opts Options) ([]Problem, error) {
+	n, err := decodeNode(r)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode config: %w", err)
+	}
+
+	if n == nil {
+		return nil, nil
+	}
+
+	return validator{file: file, opts: opts.declare(n)}.node(n, ""), nil
+}
+
+// ValidateInputs checks every input like Validate. Custom emoji sets and
+// themes declared in any input may be selected by another.
+func ValidateInputs(opts Options, ins ...Input) ([]Problem, error) {
+	ns, opts, err := decodeInputs(ins, false, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var ps []Problem
+
+	for i, in := range ins {
+		if ns[i] != nil {
+			ps = append(ps, inputProblems(in.Source, ns[i], opts)...)
+		}
+	}
+
+	return ps, nil
+}
+
+// inputProblems validates a decoded input. Layers that are not files are
+// generated so they are reported by their source without positions.
+func inputProblems(s Source, n *yaml.Node, opts Options) []Problem {
+	ps := (validator{file: s.File, opts: opts}).node(n, "")
+
+	// Generated layers have no positions within their files.
+	if s.File == "" || s.Layer == LayerProject {
+		for i := range ps {
+			ps[i].File = s.String()
+			ps[i].Line, ps[i].Column = 0, 0
+		}
+	}
+
+	return ps
+}
+
+func (v validator) node(n *yaml.Node, prefix string) []Problem {
+	var ps []Problem
+
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		k, val := n.Content[i], n.Content[i+1]
+		key := joinKey(prefix, k.Value)
+
+		switch {
+		case key == versionKey:
+			if _, err := nodeVersion(n); err != nil {
+				ps = append(ps, v.problem(key, val, "invalid value, expected a version up to "+strconv.Itoa(Version)))
+			}
+		case hasPrefixKey(key) && val.Kind == yaml.MappingNode:
+			ps = append(ps, v.node(val, key)...)
+		case hasPrefixKey(key) && val.Tag == "!!null":
+		case hasPrefixKey(key):
+			ps = append(ps, v.problem(key, val, "expected a mapping"))
+		case !HasKey(key):
+			if v.opts.Strict {
+				ps = append(ps, v.unknown(prefix, k))
+			}
+		case key == profilesKey && val.Kind == yaml.SequenceNode:
+			ps = append(ps, v.profiles(val)...)
+		case key == emojiSetsKey && val.Kind == yaml.SequenceNode:
+			ps = append(ps, v.emojiSets(val)...)
+		case key == themesKey && val.Kind == yaml.SequenceNode:
+			ps = append(ps, v.themes(val)...)
+		case key == keysKey:
+			ps = append(ps, v.keys(val)...)
+		default:
+			if p, ok := v.value(key, val); !ok {
+				ps = append(ps, p)
+			}
+		}
+	}
+
+	return ps
+}
+
+// profiles validates each profile. Problems are reported with the profile
+// name, such as "profiles.work.view.emojiSet".
+func (v validator) profiles(n *yaml.Node) []Problem {
+	var ps []Problem
+
+	for i, item := range n.Content {
+		label := fmt.Sprintf("%s.%d", profilesKey, i)
+		if name := mappingValue(item, "name"); name != nil && name.Value != "" {
+			label = fmt.Sprintf("%s.%s", profilesKey, name.Value)
+		}
+
+		if item.Kind != yaml.MappingNode {
+			ps = append(ps, v.problem(label, item, "expected a mapping"))
+
+			continue
+		}
+
+		pv := validator{file: v.file, opts: v.opts, label: label + keySeparator}
+
+		for j := 0; j+1 < len(item.Content); j += 2 {
+			k, val := item.Content[j], item.Content[j+1]
+
+			switch {
+			case k.Value == "name":
+				if err := val.Decode(new(string)); err != nil {
+					ps = append(ps, pv.problem(k.Value, val, "invalid value, expected a string"))
+				}
+			case k.Value == "match":
+				if err := val.Decode(new(Match)); err != nil {
+					ps = append(ps, pv.problem(k.Value, val, "invalid value, expected paths and remotes"))
+				}
+			case profileSettings[k.Value]:
+				setting := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map", Content: []*yaml.Node{k, val}}
+				ps = append(ps, pv.node(setting, "")...)
+			case v.opts.Strict:
+				ps = append(ps, pv.unknown("", k))
+			}
+		}
+	}
+
+	return ps
+}
+
+func (v validator) value(key string, n *yaml.Node) (Problem, bool) {
+	vs, fold := Allowed(key), true
+
+	switch {
+	case key == themeKey && len(v.opts.Themes) > 0:
+		vs, fold = v.opts.Themes, false
+	case key == emojiSetKey && len(v.opts.EmojiSets) > 0:
+		// Custom sets are matched exactly while built-in sets ignore case.
+		if n.Kind == yaml.ScalarNode && isAllowed(v.opts.EmojiSets, n.Value, false) {
+			return Problem{}, true
+		}
+
+		vs = append(vs[:len(vs):len(vs)], v.opts.EmojiSets...)
+	}
+
+	if vs != nil {
+		if n.Kind == yaml.ScalarNode && isAllowed(vs, n.Value, fold) {
+			return Problem{}, true
+		}
+
+		p := v.problem(key, n, fmt.Sprintf("invalid value, allowed values: %s", strings.Join(vs, ", ")))
+		p.Allowed = vs
+
+		return p, false
+	}
+
+	t, _ := keyType(key)
+	if err := n.Decode(reflect.New(t).Interface()); err != nil {
+		return v.problem(key, n, fmt.Sprintf("invalid value, expected %s", kindName(t))), false
+	}
+
+	return Problem{}, true
+}
+
+func (v validator) problem(key string, n *yaml.Node, msg string) Problem {
+	val, _ := nodeString(n)
+
+	if val != "" {
+		msg = fmt.Sprintf("%s: %q", msg, val)
+	}
+
+	return Problem{
+		File:    v.file,
+		Line:    n.Line,
+		Column:  n.Column,
+		Key:     v.label + key,
+		Value:   val,
+		Message: msg,
+	}
+}
+
+// unknown reports an unknown key. Keys that only differ by case from a
+// known key are corrected by migrating the config.
+func (v validator) unknown(prefix string, k *yaml.Node) Problem {
+	msg := "unknown key"
+	if name, ok := foldKey(prefix, k.Value); ok && name != k.Value {
+		msg = fmt.Sprintf("unknown key, did you mean %q? Correct with: committed config migrate", name)
+	}
+
+	return Problem{
+		File:    v.file,
+		Line:    k.Line,
+		Column:  k.Column,
+		Key:     v.label + joinKey(prefix, k.Value),
+		Message: msg,
+	}
+}
+
+func kindName(t reflect.Type) string {
+	switch t.Kind() {
+	case reflect.Bool:
+		return "a boolean"
+	case reflect.Int:
+		return "an integer"
+	case reflect.Slice:
+		return "a list"
+	case reflect.Struct, reflect.Map:
+		return "a mapping"
+	default:
+		return "a string"
+	}
+}
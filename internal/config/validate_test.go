@@ -0,0 +1,323 @@
+package config_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/mikelorant/committed/internal/config"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	type want struct {
+		problems []string
+		err      string
+	}
+
+	tests := []struct {
+		name   string
+		config string
+		opts   config.Options
+		want   want
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name: "valid",
+			config: heredoc.Doc(`
+				view:
+				  emojiSet: Devmoji
+				  theme: nord
+				commit:
+				  signoff: true
+				  scopes: [ui, cmd]
+				lint:
+				  summaryLimit: 60
+			`),
+		},
+		{
+			name: "comments_only_group",
+			config: heredoc.Doc(`
+				view:
+				  # theme: nord
+			`),
+		},
+		{
+			name:   "default_file",
+			config: config.DefaultFile,
+		},
+		{
+			name: "unknown_key",
+			config: heredoc.Doc(`
+				view:
+				  unknown: true
+				unknown: true
+			`),
+		},
+		{
+			name: "unknown_key_strict",
+			config: heredoc.Doc(`
+				view:
+				  unknown: true
+				unknown: true
+			`),
+			opts: config.Options{Strict: true},
+			want: want{
+				problems: []string{
+					"config.yaml:2:3: view.unknown: unknown key",
+					"config.yaml:3:1: unknown: unknown key",
+				},
+			},
+		},
+		{
+			name: "emoji_sets",
+			config: heredoc.Doc(`
+				view:
+				  emojiSet: team
+				emojiSets:
+				  - name: team
+				    extends: gitmoji
+				    include: [art, bug]
+				    paths: [emojis]
+			`),
+			opts: config.Options{Strict: true},
+		},
+		{
+			name: "emoji_sets_invalid",
+			config: heredoc.Doc(`
+				view:
+				  emojiSet: teams
+				emojiSets:
+				  - name: team
+				    extends: teams
+				    paths: emojis
+				    unknown: true
+				  - name: gitmoji
+				  - paths: [emojis]
+			`),
+			opts: config.Options{Strict: true},
+			want: want{
+				problems: []string{
+					`config.yaml:2:13: view.emojiSet: invalid value, allowed values: gitmoji, devmoji, emojilog, team: "teams"`,
+					`config.yaml:5:14: emojiSets.team.extends: invalid value, allowed values: gitmoji, devmoji, emojilog: "teams"`,
+					`config.yaml:6:12: emojiSets.team.paths: invalid value, expected a list: "emojis"`,
+					`config.yaml:7:5: emojiSets.team.unknown: unknown key`,
+					`config.yaml:8:11: emojiSets.gitmoji.name: invalid value, expected a name other than a built-in set: "gitmoji"`,
+					`config.yaml:9:5: emojiSets.2: missing name`,
+				},
+			},
+		},
+		{
+			name: "themes",
+			config: heredoc.Doc(`
+				view:
+				  theme: acme
+				themes:
+				  - name: acme
+				    colour: dark
+				    accent: "#0a84ff"
+				    palette:
+				      background: "#000"
+				      brightWhite: "#FFFFFF"
+			`),
+			opts: config.Options{Strict: true, Themes: []string{"builtin_dark", "nord"}},
+		},
+		{
+			name: "themes_invalid",
+			config: heredoc.Doc(`
+				view:
+				  theme: acmes
+				themes:
+				  - name: acme
+				    colour: dim
+				    accent: blue
+				    palette:
+				      red: "#ff00"
+				      orange: "#ff8800"
+				  - accent: "#0a84ff"
+				  - name: mocha
+				    palette: "#1e1e2e"
+			`),
+			opts: config.Options{Strict: true, Themes: []string{"builtin_dark", "nord"}},
+			want: want{
+				problems: []string{
+					`config.yaml:2:10: view.theme: invalid value, allowed values: builtin_dark, nord, acme, mocha: "acmes"`,
+					`config.yaml:5:13: themes.acme.colour: invalid value, allowed values: adaptive, dark, light: "dim"`,
+					`config.yaml:6:13: themes.acme.accent: invalid value, expected a hex colour such as #0a84ff: "blue"`,
+					`config.yaml:8:12: themes.acme.palette.red: invalid value, expected a hex colour such as #0a84ff: "#ff00"`,
+					`config.yaml:9:7: themes.acme.palette.orange: unknown key`,
+					`config.yaml:10:5: themes.1: missing name`,
+					`config.yaml:12:14: themes.mocha.palette: expected a mapping: "#1e1e2e"`,
+				},
+			},
+		},
+		{
+			name: "keys",
+			config: heredoc.Doc(`
+				keys:
+				  commit: [ctrl+s]
+				  help: [f1, ctrl+h]
+			`),
+			opts: config.Options{Strict: true, Actions: []string{"commit", "help"}},
+		},
+		{
+			name: "keys_invalid",
+			config: heredoc.Doc(`
+				keys:
+				  comit: [ctrl+s]
+				  help: f1
+				  cancel: []
+			`),
+			opts: config.Options{Actions: []string{"commit", "help", "cancel"}},
+			want: want{
+				problems: []string{
+					`config.yaml:2:3: keys.comit: unknown action, allowed actions: commit, help, cancel: "comit"`,
+					`config.yaml:3:9: keys.help: invalid value, expected a list of keys: "f1"`,
+					`config.yaml:4:11: keys.cancel: invalid value, expected a list of keys: "[]"`,
+				},
+			},
+		},
+		{
+			name: "keys_not_mapping",
+			config: heredoc.Doc(`
+				keys: [ctrl+s]
+			`),
+			want: want{
+				problems: []string{
+					`config.yaml:1:7: keys: invalid value, expected a mapping: "[ctrl+s]"`,
+				},
+			},
+		},
+		{
+			name: "key_case_strict",
+			config: heredoc.Doc(`
+				view:
+				  emojiset: devmoji
+			`),
+			opts: config.Options{Strict: true},
+			want: want{
+				problems: []string{
+					`config.yaml:2:3: view.emojiset: unknown key, did you mean "emojiSet"? Correct with: committed config migrate`,
+				},
+			},
+		},
+		{
+			name:   "version",
+			config: "version: 1",
+			opts:   config.Options{Strict: true},
+		},
+		{
+			name:   "version_newer",
+			config: "version: 2",
+			want: want{
+				problems: []string{
+					`config.yaml:1:10: version: invalid value, expected a version up to 1: "2"`,
+				},
+			},
+		},
+		{
+			name: "invalid_enum",
+			config: heredoc.Doc(`
+				view:
+				  emojiSet: invalid
+				lint:
+				  bodyWidth: [error]
+			`),
+			want: want{
+				problems: []string{
+					`config.yaml:2:13: view.emojiSet: invalid value, allowed values: gitmoji, devmoji, emojilog: "invalid"`,
+					`config.yaml:4:14: lint.bodyWidth: invalid value, allowed values: off, warning, error: "[error]"`,
+				},
+			},
+		},
+		{
+			name: "invalid_type",
+			config: heredoc.Doc(`
+				commit:
+				  signoff: maybe
+				lint:
+				  bodyLimit: wide
+			`),
+			want: want{
+				problems: []string{
+					`config.yaml:2:12: commit.signoff: invalid value, expected a boolean: "maybe"`,
+					`config.yaml:4:14: lint.bodyLimit: invalid value, expected an integer: "wide"`,
+				},
+			},
+		},
+		{
+			name:   "unknown_theme",
+			config: "view: {theme: nords}",
+			opts:   config.Options{Themes: []string{"dracula", "nord"}},
+			want: want{
+				problems: []string{
+					`config.yaml:1:15: view.theme: invalid value, allowed values: dracula, nord: "nords"`,
+				},
+			},
+		},
+		{
+			name: "profiles",
+			config: heredoc.Doc(`
+				profiles:
+				  - name: work
+				    match: {paths: [~/work]}
+				    view: {emojiSet: devmojis}
+				    lint: {bodyLimit: 80}
+				  - commit: {signoff: maybe}
+				  - invalid
+			`),
+			opts: config.Options{Strict: true},
+			want: want{
+				problems: []string{
+					`config.yaml:4:22: profiles.work.view.emojiSet: invalid value, allowed values: gitmoji, devmoji, emojilog: "devmojis"`,
+					"config.yaml:5:5: profiles.work.lint: unknown key",
+					`config.yaml:6:23: profiles.1.commit.signoff: invalid value, expected a boolean: "maybe"`,
+					`config.yaml:7:5: profiles.2: expected a mapping: "invalid"`,
+				},
+			},
+		},
+		{
+			name:   "group_not_mapping",
+			config: "view: nord",
+			want: want{
+				problems: []string{
+					`config.yaml:1:7: view: expected a mapping: "nord"`,
+				},
+			},
+		},
+		{
+			name:   "not_mapping",
+			config: "invalid",
+			want: want{
+				err: "unable to decode config: config must be a mapping",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ps, err := config.Validate(strings.NewReader(tt.config), "config.yaml", tt.opts)
+			if tt.want.err != "" {
+				assert.EqualError(t, err, tt.want.err)
+				return
+			}
+			assert.NoError(t, err)
+
+			var got []string
+			for _, p := range ps {
+				got = append(got, p.String())
+			}
+
+			assert.Equal(t, tt.want.problems, got)
+		})
+	}
+}
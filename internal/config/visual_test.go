@@ -67,6 +67,35 @@ func TestUnmarshallYAMLCompatibility(t *testing.T) {
 	}
 }
 
+func TestUnmarshallYAMLEditMode(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		want  config.EditMode
+	}{
+		{name: "empty", input: "", want: config.EditModeUnset},
+		{name: "default", input: "default", want: config.EditModeDefault},
+		{name: "vim", input: "vim", want: config.EditModeVim},
+		{name: "case", input: "Vim", want: config.EditModeVim},
+		{name: "invalid", input: "invalid", want: config.EditModeUnset},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var got config.EditMode
+
+			yaml.Unmarshal([]byte(tt.input), &got)
+			assert.Equal(t, tt.want, got, tt.name)
+		})
+	}
+}
+
 func TestUnmarshallYAMLColour(t *testing.T) {
 	t.Parallel()
 
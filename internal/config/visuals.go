@@ -10,6 +10,7 @@ type (
 	Compatibility int
 	Theme         int
 	Colour        int
+	EditMode      int
 )
 
 const (
@@ -26,6 +27,12 @@ const (
 	CompatibilityKitty
 )
 
+const (
+	EditModeUnset EditMode = iota
+	EditModeDefault
+	EditModeVim
+)
+
 const (
 	ColourUnset Colour = iota
 	ColourAdaptive
@@ -65,6 +72,20 @@ func (c Compatibility) MarshalYAML() (interface{}, error) {
 	}[c], nil
 }
 
+func (e *EditMode) UnmarshalYAML(value *yaml.Node) error {
+	*e = ParseEditMode(value.Value)
+
+	return nil
+}
+
+func (e EditMode) MarshalYAML() (interface{}, error) {
+	return []string{
+		"",
+		"default",
+		"vim",
+	}[e], nil
+}
+
 func (c *Colour) UnmarshalYAML(value *yaml.Node) error {
 	*c = ParseColour(value.Value)
 
@@ -112,3 +133,13 @@ func ParseColour(str string) Colour {
 
 	return colour[strings.ToLower(str)]
 }
+
+func ParseEditMode(str string) EditMode {
+	editMode := map[string]EditMode{
+		"":        EditModeUnset,
+		"default": EditModeDefault,
+		"vim":     EditModeVim,
+	}
+
+	return editMode[strings.ToLower(str)]
+}
@@ -2,7 +2,9 @@ package emoji
 
 import (
 	_ "embed"
+	"errors"
 	"fmt"
+	"io"
 	"strings"
 
 	"github.com/forPelevin/gomoji"
@@ -15,18 +17,25 @@ type Set struct {
 
 	profile   Profile
 	rawEmojis string
+	name      string
+	include   []string
+	exclude   []string
+	extra     []Emoji
 }
 
+// Emoji is an entry of an emoji set. Custom sets are decoded with the same
+// fields as the embedded sets.
 type Emoji struct {
-	Name        string `json:"name"`
-	Character   string `json:"emoji"`
-	Description string `json:"description"`
-	Characters  int    `json:"characters"`
-	Codepoint   string `json:"codepoint"`
-	Hex         string `json:"hex"`
-	Shortcode   string `json:"shortcode"`
-	Variant     bool   `json:"variant"`
-	ZWJ         bool   `json:"zwj"`
+	Name        string   `json:"name"`
+	Character   string   `json:"emoji"`
+	Description string   `json:"description"`
+	Characters  int      `json:"characters"`
+	Codepoint   string   `json:"codepoint"`
+	Hex         string   `json:"hex"`
+	Shortcode   string   `json:"shortcode"`
+	Variant     bool     `json:"variant"`
+	ZWJ         bool     `json:"zwj"`
+	Keywords    []string `json:"keywords,omitempty"`
 }
 
 type NullEmoji struct {
@@ -51,6 +60,9 @@ const (
 	emojiLogName = "emojilog"
 )
 
+// NoProfile starts a set without any embedded emojis.
+const NoProfile Profile = -1
+
 const (
 	DefaultProfile Profile = iota
 	GitmojiProfile
@@ -69,10 +81,22 @@ func New(opts ...func(*Set)) *Set {
 	}
 
 	es.load(es.profile)
+	es.customise()
 
 	return &es
 }
 
+// Decode reads a list of emojis in the same format as the embedded sets.
+func Decode(r io.Reader) ([]Emoji, error) {
+	var es []Emoji
+
+	if err := yaml.NewDecoder(r).Decode(&es); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("unable to decode emojis: %w", err)
+	}
+
+	return es, nil
+}
+
 func (es *Set) Find(str string) NullEmoji {
 	switch {
 	case HasCharacter(str):
@@ -148,6 +172,9 @@ func (es *Set) load(p Profile) {
 	case EmojiLogProfile:
 		es.Name = emojiLogName
 		es.rawEmojis = emojiLog
+	case NoProfile:
+		es.Name = ""
+		es.rawEmojis = ""
 	default:
 		es.Name = gitmojiName
 		es.rawEmojis = gitmoji
@@ -164,12 +191,87 @@ func (es *Set) load(p Profile) {
 	}
 }
 
+// customise renames the set, filters the embedded emojis and adds the extra
+// emojis. An extra emoji replaces an embedded emoji with the same name.
+func (es *Set) customise() {
+	if es.name != "" {
+		es.Name = es.name
+	}
+
+	var ems []Emoji
+
+	for _, e := range es.Emojis {
+		if len(es.include) > 0 && !matchAny(e, es.include) {
+			continue
+		}
+
+		if matchAny(e, es.exclude) {
+			continue
+		}
+
+		ems = append(ems, e)
+	}
+
+	for _, x := range es.extra {
+		replaced := false
+
+		for i, e := range ems {
+			if e.Name == x.Name {
+				ems[i], replaced = x, true
+
+				break
+			}
+		}
+
+		if !replaced {
+			ems = append(ems, x)
+		}
+	}
+
+	es.Emojis = ems
+}
+
+// matchAny reports if an emoji has any of the names or shortcodes. A
+// shortcode may be given with or without colons.
+func matchAny(e Emoji, names []string) bool {
+	for _, n := range names {
+		if n == e.Name || n == e.Shortcode || ":"+n+":" == e.Shortcode {
+			return true
+		}
+	}
+
+	return false
+}
+
 func WithEmojiSet(p Profile) func(*Set) {
 	return func(e *Set) {
 		e.profile = p
 	}
 }
 
+// WithName names a custom set.
+func WithName(name string) func(*Set) {
+	return func(e *Set) {
+		e.name = name
+	}
+}
+
+// WithFilter limits the embedded emojis to those included, when any are
+// given, and removes those excluded.
+func WithFilter(include, exclude []string) func(*Set) {
+	return func(e *Set) {
+		e.include = include
+		e.exclude = exclude
+	}
+}
+
+// WithEmojis adds emojis to the set.
+func WithEmojis(ems []Emoji) func(*Set) {
+	return func(e *Set) {
+		e.extra = append(e.extra, ems...)
+	}
+}
+
 func Has(str string) bool {
 	return HasCharacter(str) || HasShortcode(str)
 }
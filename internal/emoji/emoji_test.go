@@ -1,10 +1,12 @@
 package emoji_test
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/mikelorant/committed/internal/emoji"
 
+	"github.com/MakeNowJust/heredoc/v2"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -106,6 +108,115 @@ func TestNew(t *testing.T) {
 	}
 }
 
+func TestNewCustom(t *testing.T) {
+	t.Parallel()
+
+	deploy := emoji.Emoji{Name: "deploy", Character: "🚀", Shortcode: ":rocket:"}
+	art := emoji.Emoji{Name: "art", Character: "🖌️", Shortcode: ":paintbrush:"}
+
+	type want struct {
+		name   string
+		emojis []string
+		first  emoji.Emoji
+	}
+
+	tests := []struct {
+		name    string
+		options []func(*emoji.Set)
+		want    want
+	}{
+		{
+			name: "no_profile",
+			options: []func(*emoji.Set){
+				emoji.WithEmojiSet(emoji.NoProfile),
+				emoji.WithName("team"),
+				emoji.WithEmojis([]emoji.Emoji{deploy}),
+			},
+			want: want{
+				name:   "team",
+				emojis: []string{"deploy"},
+				first:  deploy,
+			},
+		},
+		{
+			name: "include",
+			options: []func(*emoji.Set){
+				emoji.WithFilter([]string{"art", ":bug:", "zap"}, nil),
+			},
+			want: want{
+				name:   "gitmoji",
+				emojis: []string{"art", "high-voltage", "bug"},
+				first:  firstGitmojiEmoji,
+			},
+		},
+		{
+			name: "exclude",
+			options: []func(*emoji.Set){
+				emoji.WithFilter([]string{"art", "bug", "zap"}, []string{"zap"}),
+			},
+			want: want{
+				name:   "gitmoji",
+				emojis: []string{"art", "bug"},
+				first:  firstGitmojiEmoji,
+			},
+		},
+		{
+			name: "replace",
+			options: []func(*emoji.Set){
+				emoji.WithFilter([]string{"art", "bug"}, nil),
+				emoji.WithEmojis([]emoji.Emoji{art, deploy}),
+			},
+			want: want{
+				name:   "gitmoji",
+				emojis: []string{"art", "bug", "deploy"},
+				first:  art,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			e := emoji.New(tt.options...)
+
+			var names []string
+			for _, em := range e.Emojis {
+				names = append(names, em.Name)
+			}
+
+			assert.Equal(t, tt.want.name, e.Name)
+			assert.Equal(t, tt.want.emojis, names)
+			assert.Equal(t, tt.want.first, e.Emojis[0])
+		})
+	}
+}
+
+func TestDecode(t *testing.T) {
+	t.Parallel()
+
+	es, err := emoji.Decode(strings.NewReader(heredoc.Doc(`
+		- name: art
+		  emoji: 🎨
+		  description: Improve structure / format of the code.
+		  characters: 1
+		  codepoint: 1f3a8
+		  hex: F0 9F 8E A8
+		  shortcode: ":art:"
+	`)))
+	assert.NoError(t, err)
+	assert.Equal(t, []emoji.Emoji{firstGitmojiEmoji}, es)
+
+	es, err = emoji.Decode(strings.NewReader(""))
+	assert.NoError(t, err)
+	assert.Empty(t, es)
+
+	_, err = emoji.Decode(strings.NewReader("invalid"))
+	assert.ErrorContains(t, err, "unable to decode emojis")
+}
+
 func TestFind(t *testing.T) {
 	t.Parallel()
 
@@ -1,58 +1,273 @@
 package fuzzy
 
 import (
-	"fmt"
 	"sort"
-	"strconv"
 	"strings"
-
-	"github.com/lithammer/fuzzysearch/fuzzy"
+	"unicode"
 )
 
+// Item is a searchable item such as an emoji or an author.
 type Item interface {
-	Terms() []string
+	Fields() []Field
+}
+
+// Field is a searchable value of an item. Matches in fields with a higher
+// weight rank first.
+type Field struct {
+	Value  string
+	Weight int
+}
+
+// Weights of the fields of an item.
+const (
+	WeightLow    = 1
+	WeightMedium = 2
+	WeightHigh   = 3
+)
+
+// Scores of a match within a field, from the best match.
+const (
+	scoreExact      = 100
+	scorePrefix     = 80
+	scoreWordPrefix = 60
+	scoreSubstring  = 40
+	scoreSubseq     = 20
+	scoreTypo       = 10
+
+	minSubseqLength = 2
+	minTypoLength   = 4
+	maxTypoLength   = 8
+)
+
+// Index is a precomputed index of the fields of items so that ranking does
+// not prepare the items on every search.
+type Index struct {
+	size    int
+	entries []entry
 }
 
+type entry struct {
+	item   int
+	weight int
+	value  string
+	words  []string
+}
+
+type match struct {
+	item  int
+	score int
+}
+
+// NewIndex indexes the fields of items.
+func NewIndex(items []Item) *Index {
+	idx := Index{size: len(items)}
+
+	for i, item := range items {
+		for _, f := range item.Fields() {
+			v := strings.ToLower(strings.TrimSpace(f.Value))
+			if v == "" {
+				continue
+			}
+
+			idx.entries = append(idx.entries, entry{
+				item:   i,
+				weight: f.Weight,
+				value:  v,
+				words:  words(v),
+			})
+		}
+	}
+
+	return &idx
+}
+
+// Rank returns the positions of the items matching a term from the best
+// match. Each word of the term must match a field. Items with equal scores
+// keep their order and an empty term returns every item in order.
 func Rank(term string, items []Item) []int {
-	idx := make([]int, len(items))
-	null := string(rune(0))
+	return NewIndex(items).Rank(term)
+}
 
-	if len(term) < 3 {
-		for i := 0; i < len(items); i++ {
-			idx[i] = i
+// Rank returns the positions of the indexed items matching a term.
+func (idx *Index) Rank(term string) []int {
+	ts := strings.Fields(strings.ToLower(term))
+
+	if len(ts) == 0 {
+		res := make([]int, idx.size)
+		for i := range res {
+			res[i] = i
 		}
-		return idx
+
+		return res
 	}
 
-	var lines []string
-	for i, item := range items {
-		for _, t := range item.Terms() {
-			line := fmt.Sprintf("%v%v%v", i, null, t)
-			lines = append(lines, line)
+	scores := make([]int, idx.size)
+
+	for _, t := range ts {
+		best := make([]int, idx.size)
+
+		for _, e := range idx.entries {
+			if s := score(t, e) * e.weight; s > best[e.item] {
+				best[e.item] = s
+			}
+		}
+
+		for i, s := range best {
+			// Every word of the term must match.
+			if s == 0 || scores[i] < 0 {
+				scores[i] = -1
+
+				continue
+			}
+
+			scores[i] += s
+		}
+	}
+
+	var ms []match
+
+	for i, s := range scores {
+		if s > 0 {
+			ms = append(ms, match{item: i, score: s})
+		}
+	}
+
+	sort.SliceStable(ms, func(i, j int) bool {
+		return ms[i].score > ms[j].score
+	})
+
+	res := make([]int, len(ms))
+	for i, m := range ms {
+		res[i] = m.item
+	}
+
+	return res
+}
+
+// score rates how well a term matches a field. Short terms must match
+// exactly or as a substring while longer terms may be spread out within a
+// word or have a typo.
+func score(term string, e entry) int {
+	switch {
+	case e.value == term:
+		return scoreExact
+	case strings.HasPrefix(e.value, term):
+		return scorePrefix
+	}
+
+	for _, w := range e.words {
+		if strings.HasPrefix(w, term) {
+			return scoreWordPrefix
 		}
 	}
 
-	ranks := fuzzy.RankFindFold(term, lines)
-	sort.Sort(ranks)
+	switch {
+	case strings.Contains(e.value, term):
+		return scoreSubstring
+	case len(term) >= minSubseqLength && subsequence(term, e.words):
+		return scoreSubseq
+	case len(term) >= minTypoLength && typo(term, e.words):
+		return scoreTypo
+	}
+
+	return 0
+}
+
+// subsequence reports if the runes of a term appear in order within a
+// word, such as "bldg" for "building".
+func subsequence(term string, ws []string) bool {
+	for _, w := range ws {
+		rs := []rune(term)
+
+		for _, r := range w {
+			if r == rs[0] {
+				rs = rs[1:]
+			}
 
-	idx = make([]int, 0)
-	for _, v := range ranks {
-		pos := strings.Split(v.Target, null)[0]
-		i, _ := strconv.Atoi(pos)
-		if !contains(idx, i) {
-			//nolint:makezero
-			idx = append(idx, i)
+			if len(rs) == 0 {
+				return true
+			}
 		}
 	}
 
-	return idx
+	return false
 }
 
-func contains[T comparable](vs []T, val T) bool {
-	for _, v := range vs {
-		if v == val {
+// typo reports if a term is within an edit of a word, or of the start of a
+// word, such as "sparkels" for "sparkles". Longer terms allow two edits.
+func typo(term string, ws []string) bool {
+	limit := 1
+	if len(term) >= maxTypoLength {
+		limit = 2
+	}
+
+	// Punctuation such as the colons of a shortcode is not a typo.
+	tr := []rune(strings.TrimFunc(term, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	}))
+
+	for _, w := range ws {
+		wr := []rune(w)
+
+		if distance(tr, wr) <= limit {
+			return true
+		}
+
+		// The term may be the start of a longer word.
+		if len(wr) > len(tr) && distance(tr, wr[:len(tr)]) <= limit {
 			return true
 		}
 	}
+
 	return false
 }
+
+// distance is the optimal string alignment distance, which counts
+// insertions, deletions, substitutions and transpositions of adjacent
+// runes.
+func distance(a, b []rune) int {
+	d := make([][]int, len(a)+1)
+	for i := range d {
+		d[i] = make([]int, len(b)+1)
+		d[i][0] = i
+	}
+
+	for j := range d[0] {
+		d[0][j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+
+			d[i][j] = min(d[i-1][j]+1, d[i][j-1]+1, d[i-1][j-1]+cost)
+
+			if i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+				d[i][j] = min(d[i][j], d[i-2][j-2]+1)
+			}
+		}
+	}
+
+	return d[len(a)][len(b)]
+}
+
+// words splits a value into words, such as "sparkles" from ":sparkles:" or
+// "john" and "doe" from "john.doe@example.com".
+func words(value string) []string {
+	return strings.FieldsFunc(value, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+}
+
+func min(v int, vs ...int) int {
+	for _, n := range vs {
+		if n < v {
+			v = n
+		}
+	}
+
+	return v
+}
@@ -9,11 +9,11 @@ import (
 )
 
 type MockItem struct {
-	terms []string
+	fields []fuzzy.Field
 }
 
-func (m MockItem) Terms() []string {
-	return m.terms
+func (m MockItem) Fields() []fuzzy.Field {
+	return m.fields
 }
 
 func TestRank(t *testing.T) {
@@ -67,7 +67,7 @@ func TestRank(t *testing.T) {
 		},
 		{
 			name:      "terms_match_none",
-			inputTerm: "none",
+			inputTerm: "seven",
 			inputTerms: [][]string{
 				{"one", "two", "three"},
 				{"two", "three", "four"},
@@ -93,7 +93,7 @@ func TestRank(t *testing.T) {
 				{"two"},
 				{"three"},
 			},
-			want: []int{0, 1, 2},
+			want: []int{0},
 		},
 		{
 			name:       "empty_term",
@@ -132,9 +132,108 @@ func castToFuzzyItems(items [][]string) []fuzzy.Item {
 	res := make([]fuzzy.Item, len(items))
 	for i, it := range items {
 		var item MockItem
-		item.terms = it
+		for _, t := range it {
+			item.fields = append(item.fields, fuzzy.Field{Value: t, Weight: fuzzy.WeightLow})
+		}
 		res[i] = item
 	}
 
 	return res
 }
+
+func TestIndexRank(t *testing.T) {
+	t.Parallel()
+
+	emojis := []fuzzy.Item{
+		MockItem{fields: []fuzzy.Field{
+			{Value: ":sparkles:", Weight: fuzzy.WeightHigh},
+			{Value: "sparkles", Weight: fuzzy.WeightHigh},
+			{Value: "Introduce new features.", Weight: fuzzy.WeightLow},
+		}},
+		MockItem{fields: []fuzzy.Field{
+			{Value: ":bug:", Weight: fuzzy.WeightHigh},
+			{Value: "bug", Weight: fuzzy.WeightHigh},
+			{Value: "Fix a bug.", Weight: fuzzy.WeightLow},
+		}},
+		MockItem{fields: []fuzzy.Field{
+			{Value: ":ambulance:", Weight: fuzzy.WeightHigh},
+			{Value: "ambulance", Weight: fuzzy.WeightHigh},
+			{Value: "Critical hotfix.", Weight: fuzzy.WeightLow},
+			{Value: "bug", Weight: fuzzy.WeightMedium},
+		}},
+		MockItem{fields: []fuzzy.Field{
+			{Value: ":memo:", Weight: fuzzy.WeightHigh},
+			{Value: "memo", Weight: fuzzy.WeightHigh},
+			{Value: "Add or update documentation.", Weight: fuzzy.WeightLow},
+		}},
+	}
+
+	tests := []struct {
+		name string
+		term string
+		want []int
+	}{
+		{
+			name: "empty",
+			term: "",
+			want: []int{0, 1, 2, 3},
+		},
+		{
+			name: "name",
+			term: "bug",
+			want: []int{1, 2},
+		},
+		{
+			name: "shortcode",
+			term: ":sparkles:",
+			want: []int{0},
+		},
+		{
+			name: "description",
+			term: "documentation",
+			want: []int{3},
+		},
+		{
+			name: "single_character",
+			term: "m",
+			want: []int{3, 2},
+		},
+		{
+			name: "typo",
+			term: "sparkels",
+			want: []int{0},
+		},
+		{
+			name: "typo_shortcode",
+			term: ":abmulance:",
+			want: []int{2},
+		},
+		{
+			name: "words",
+			term: "fix bug",
+			want: []int{1, 2},
+		},
+		{
+			name: "words_unmatched",
+			term: "fix memo",
+			want: []int{},
+		},
+		{
+			name: "case",
+			term: "MEMO",
+			want: []int{3},
+		},
+	}
+
+	idx := fuzzy.NewIndex(emojis)
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, idx.Rank(tt.term))
+		})
+	}
+}
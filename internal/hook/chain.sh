@@ -0,0 +1,7 @@
+
+# Run the original hook that was replaced by Committed.
+original="${0}.backup"
+
+if [[ -x "${original}" ]]; then
+	"${original}" "$@" || exit $?
+fi
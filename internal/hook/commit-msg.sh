@@ -0,0 +1,13 @@
+#!/usr/bin/env bash # Code generated by Committed. DO NOT EDIT.
+
+# It takes a single parameter, the name of the file that holds the proposed
+# commit log message. Exiting with a non-zero status causes the command to
+# abort. The message has already been edited, including when it was given
+# with a -m or -F option.
+#
+# Source: https://git-scm.com/docs/githooks#_commit_msg
+
+# name of the file that contains the commit log message
+: "${message_file:=$1}"
+
+committed lint --file "${message_file}"
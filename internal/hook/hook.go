@@ -14,18 +14,22 @@ type Hook struct {
 	Locater Locater
 	Deleter Deleter
 	Opener  Opener
+	Renamer Renamer
 	Runner  Runner
 	Stater  Stater
 
 	Location  string
 	Directory string
-
-	file *os.File
+	Scope     Scope
 }
 
 type Options struct {
 	Install   bool
 	Uninstall bool
+	Validate  bool
+	Chain     bool
+	Status    bool
+	Scope     Scope
 	Commit    bool
 }
 
@@ -33,7 +37,8 @@ type (
 	Creator func(name string, flag int, perm os.FileMode) (*os.File, error)
 	Deleter func(string) error
 	Opener  func(string) (*os.File, error)
-	Locater func(run Runner) (string, error)
+	Renamer func(string, string) error
+	Locater func(run Runner, scope Scope) (string, error)
 	Runner  func(io.Writer, string, []string) error
 	Stater  func(string) (os.FileInfo, error)
 )
@@ -45,11 +50,20 @@ type (
 //go:embed prepare-commit-msg.sh
 var PrepareGitMessage string
 
-var GitHook = "hooks/prepare-commit-msg"
+var GitHook = "prepare-commit-msg"
+
+//go:embed commit-msg.sh
+var CommitMessage string
+
+var ValidateHook = "commit-msg"
+
+//go:embed chain.sh
+var ChainHook string
 
 var (
 	ErrAction    = errors.New("invalid hook action")
 	ErrUnmanaged = errors.New("hook file unmanaged")
+	ErrBackup    = errors.New("hook backup already exists")
 )
 
 const (
@@ -61,6 +75,9 @@ const (
 
 const (
 	Marker = "Code generated by Committed. DO NOT EDIT."
+
+	// BackupSuffix is appended to an existing hook when it is chained.
+	BackupSuffix = ".backup"
 )
 
 func New() Hook {
@@ -68,6 +85,7 @@ func New() Hook {
 		Creator: os.OpenFile,
 		Deleter: os.Remove,
 		Opener:  os.Open,
+		Renamer: os.Rename,
 		Locater: Locate,
 		Runner:  shell.Run,
 		Stater:  os.Stat,
@@ -75,9 +93,11 @@ func New() Hook {
 }
 
 func (h *Hook) Do(opts Options) error {
+	h.Scope = opts.Scope
+
 	switch {
 	case opts.Install:
-		return h.Install()
+		return h.Install(opts.Validate, opts.Chain)
 	case opts.Uninstall:
 		return h.Uninstall()
 	}
@@ -9,8 +9,17 @@ import (
 	"strings"
 )
 
-func (h *Hook) Install() error {
-	loc, err := h.Locater(h.Runner)
+type script struct {
+	location string
+	content  string
+	managed  bool
+}
+
+// Install writes the prepare-commit-msg hook and, when validating, the
+// commit-msg hook. Nothing is written unless every hook is managed or, when
+// chaining, an existing hook can be moved to a backup that runs first.
+func (h *Hook) Install(validate, chain bool) error {
+	loc, err := h.Locater(h.Runner, h.Scope)
 	if err != nil {
 		return fmt.Errorf("unable to determine hook location: %w", err)
 	}
@@ -21,58 +30,95 @@ func (h *Hook) Install() error {
 
 	h.Location = path.Join(loc, GitHook)
 
-	manage, err := h.manage()
-	if err != nil {
-		return fmt.Errorf("unable to determine managed state: %w", err)
+	scripts := []script{
+		{location: h.Location, content: PrepareGitMessage},
 	}
 
-	if !manage {
-		return ErrUnmanaged
+	if validate {
+		scripts = append(scripts, script{
+			location: path.Join(loc, ValidateHook),
+			content:  CommitMessage,
+		})
 	}
 
-	_, err = h.file.WriteString(PrepareGitMessage)
-	if err != nil {
-		return fmt.Errorf("unable to write message: %w", err)
+	for i, s := range scripts {
+		managed, err := h.isManaged(s.location)
+		if err != nil {
+			return fmt.Errorf("unable to determine managed state: %w", err)
+		}
+
+		switch {
+		case managed:
+		case !chain:
+			return fmt.Errorf("%w: %v", ErrUnmanaged, s.location)
+		case h.exists(backup(s.location)):
+			return fmt.Errorf("%w: %v", ErrBackup, backup(s.location))
+		}
+
+		scripts[i].managed = managed
+	}
+
+	for _, s := range scripts {
+		if !s.managed {
+			if err := h.Renamer(s.location, backup(s.location)); err != nil {
+				return fmt.Errorf("unable to back up hook: %w", err)
+			}
+		}
+
+		// A hook that was previously chained keeps running its backup.
+		if chain || h.exists(backup(s.location)) {
+			s.content = chainScript(s.content)
+		}
+
+		if err := h.write(s); err != nil {
+			return fmt.Errorf("unable to write hook: %w", err)
+		}
 	}
 
 	return nil
 }
 
-func (h *Hook) manage() (bool, error) {
-	managed, err := h.isManaged()
-	if err != nil {
-		return false, err
-	}
+// chainScript inserts the call to the original hook after the signature line.
+func chainScript(content string) string {
+	sig, rest, _ := strings.Cut(content, "\n")
 
-	if !managed {
-		return false, nil
-	}
+	return sig + "\n" + ChainHook + rest
+}
+
+func backup(location string) string {
+	return location + BackupSuffix
+}
 
-	fh, err := h.Creator(h.Location, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0o755)
+func (h *Hook) write(s script) error {
+	fh, err := h.Creator(s.location, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0o755)
 	if err != nil {
-		return false, fmt.Errorf("unable to create file: %w", err)
+		return fmt.Errorf("unable to create file: %w", err)
 	}
+	defer fh.Close()
 
-	h.file = fh
+	if _, err := fh.WriteString(s.content); err != nil {
+		return fmt.Errorf("unable to write message: %w", err)
+	}
 
-	return true, nil
+	return nil
 }
 
-func (h *Hook) isManaged() (bool, error) {
-	if !h.exists() {
+func (h *Hook) isManaged(location string) (bool, error) {
+	if !h.exists(location) {
 		return true, nil
 	}
 
-	fh, err := h.Opener(h.Location)
+	fh, err := h.Opener(location)
 	if err != nil {
 		return false, fmt.Errorf("unable to open file: %w", err)
 	}
+	defer fh.Close()
 
 	return checkSignature(fh)
 }
 
-func (h *Hook) exists() bool {
-	_, err := h.Stater(h.Location)
+func (h *Hook) exists(location string) bool {
+	_, err := h.Stater(location)
 
 	return err == nil
 }
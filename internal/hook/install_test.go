@@ -5,6 +5,7 @@ import (
 	"io"
 	"os"
 	"path"
+	"strings"
 	"testing"
 
 	"github.com/mikelorant/committed/internal/hook"
@@ -17,7 +18,7 @@ type (
 	Stater  func(string) (os.FileInfo, error)
 	Opener  func(string) (*os.File, error)
 	Creator func(name string, flag int, perm os.FileMode) (*os.File, error)
-	Locater func(run Runner) (string, error)
+	Locater func(run Runner, scope hook.Scope) (string, error)
 )
 
 var errMock = errors.New("error")
@@ -34,8 +35,15 @@ func MockCreate(err error) func(name string, flag int, perm os.FileMode) (*os.Fi
 	}
 }
 
-func MockLocater(t *testing.T, emptyLoc bool, data string, err error) func(run hook.Runner) (string, error) {
-	return func(run hook.Runner) (string, error) {
+// MockFiles are the contents of existing hooks.
+type MockFiles struct {
+	hook     string
+	validate string
+	backup   string
+}
+
+func MockLocater(t *testing.T, emptyLoc bool, files MockFiles, err error) func(hook.Runner, hook.Scope) (string, error) {
+	return func(hook.Runner, hook.Scope) (string, error) {
 		if err != nil {
 			return "", err
 		}
@@ -49,11 +57,19 @@ func MockLocater(t *testing.T, emptyLoc bool, data string, err error) func(run h
 			return "", nil
 		}
 
-		if data == "" {
+		if files.validate != "" {
+			_ = os.WriteFile(path.Join(tmpDir, hook.ValidateHook), []byte(files.validate), 0o755)
+		}
+
+		if files.backup != "" {
+			_ = os.WriteFile(file+hook.BackupSuffix, []byte(files.backup), 0o755)
+		}
+
+		if files.hook == "" {
 			return tmpDir, nil
 		}
 
-		_ = os.WriteFile(file, []byte(data), 0o755)
+		_ = os.WriteFile(file, []byte(files.hook), 0o755)
 
 		return tmpDir, nil
 	}
@@ -95,12 +111,16 @@ func TestInstall(t *testing.T) {
 	t.Parallel()
 
 	type args struct {
-		data      string
-		emptyLoc  bool
-		createErr error
-		locateErr error
-		openErr   error
-		runErr    error
+		data         string
+		validateData string
+		backupData   string
+		validate     bool
+		chain        bool
+		emptyLoc     bool
+		createErr    error
+		locateErr    error
+		openErr      error
+		runErr       error
 	}
 
 	type want struct {
@@ -145,7 +165,38 @@ func TestInstall(t *testing.T) {
 				createErr: errMock,
 			},
 			want: want{
-				err: "unable to determine managed state: unable to create file: error",
+				err: "unable to write hook: unable to create file: error",
+			},
+		},
+		{
+			name: "validate",
+			args: args{
+				validate: true,
+			},
+		},
+		{
+			name: "validate_managed",
+			args: args{
+				data:         hook.Marker,
+				validateData: hook.Marker,
+				validate:     true,
+			},
+		},
+		{
+			name: "validate_unmanaged",
+			args: args{
+				data:         hook.Marker,
+				validateData: "unmanaged",
+				validate:     true,
+			},
+			want: want{
+				err: "hook file unmanaged",
+			},
+		},
+		{
+			name: "validate_unmanaged_ignored",
+			args: args{
+				validateData: "unmanaged",
 			},
 		},
 		{
@@ -178,18 +229,144 @@ func TestInstall(t *testing.T) {
 			h := hook.Hook{
 				Creator: MockCreate(tt.args.createErr),
 				Opener:  MockOpen(tt.args.openErr),
-				Locater: MockLocater(t, tt.args.emptyLoc, tt.args.data, tt.args.locateErr),
-				Runner:  MockRun(tt.args.data, tt.args.runErr),
-				Stater:  MockStat(),
+				Locater: MockLocater(t, tt.args.emptyLoc, MockFiles{
+					hook:     tt.args.data,
+					validate: tt.args.validateData,
+					backup:   tt.args.backupData,
+				}, tt.args.locateErr),
+				Runner: MockRun(tt.args.data, tt.args.runErr),
+				Stater: MockStat(),
+			}
+
+			err := h.Install(tt.args.validate, tt.args.chain)
+			if tt.want.err != "" {
+				assert.Error(t, err)
+				assert.ErrorContains(t, err, tt.want.err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestInstallChain(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		data       string
+		backupData string
+		chain      bool
+		renameErr  error
+	}
+
+	type want struct {
+		backup string
+		chain  bool
+		err    string
+	}
+
+	tests := []struct {
+		name string
+		args args
+		want want
+	}{
+		{
+			name: "unmanaged",
+			args: args{
+				data:  "unmanaged",
+				chain: true,
+			},
+			want: want{
+				backup: "unmanaged",
+				chain:  true,
+			},
+		},
+		{
+			name: "default",
+			args: args{
+				chain: true,
+			},
+			want: want{
+				chain: true,
+			},
+		},
+		{
+			name: "managed_backup",
+			args: args{
+				data:       hook.Marker,
+				backupData: "unmanaged",
+			},
+			want: want{
+				backup: "unmanaged",
+				chain:  true,
+			},
+		},
+		{
+			name: "unmanaged_backup",
+			args: args{
+				data:       "unmanaged",
+				backupData: "unmanaged",
+				chain:      true,
+			},
+			want: want{
+				err: "hook backup already exists",
+			},
+		},
+		{
+			name: "rename_error",
+			args: args{
+				data:      "unmanaged",
+				chain:     true,
+				renameErr: errMock,
+			},
+			want: want{
+				err: "unable to back up hook: error",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			h := hook.Hook{
+				Creator: os.OpenFile,
+				Opener:  os.Open,
+				Renamer: MockRename(tt.args.renameErr),
+				Locater: MockLocater(t, false, MockFiles{
+					hook:   tt.args.data,
+					backup: tt.args.backupData,
+				}, nil),
+				Runner: MockRun("", nil),
+				Stater: os.Stat,
 			}
 
-			err := h.Install()
+			err := h.Install(false, tt.args.chain)
 			if tt.want.err != "" {
 				assert.Error(t, err)
 				assert.ErrorContains(t, err, tt.want.err)
 				return
 			}
 			assert.NoError(t, err)
+
+			data, _ := os.ReadFile(h.Location)
+			assert.Contains(t, string(data), hook.Marker)
+			assert.Equal(t, tt.want.chain, strings.Contains(string(data), hook.ChainHook))
+
+			backup, _ := os.ReadFile(h.Location + hook.BackupSuffix)
+			assert.Equal(t, tt.want.backup, string(backup))
 		})
 	}
 }
+
+func MockRename(err error) func(string, string) error {
+	return func(from, to string) error {
+		if err != nil {
+			return err
+		}
+
+		return os.Rename(from, to)
+	}
+}
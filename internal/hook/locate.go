@@ -5,31 +5,97 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"path"
 	"strings"
 )
 
+type Scope int
+
+const (
+	ScopeUnset Scope = iota
+	ScopeGlobal
+	ScopeLocal
+)
+
 var (
 	gitCommand        = "git"
 	gitGlobalArgs     = []string{"config", "--get", "core.hooksPath"}
 	gitRepositoryArgs = []string{"rev-parse", "--absolute-git-dir"}
+	gitToplevelArgs   = []string{"rev-parse", "--show-toplevel"}
+)
+
+const repositoryHooks = "hooks"
+
+var (
+	ErrLocation = errors.New("no hook location found")
+	ErrScope    = errors.New("invalid hook scope")
 )
 
-var ErrLocation = errors.New("no hook location found")
+// Locate returns the hooks directory for the scope. Global is the directory
+// set by core.hooksPath and local is the hooks directory of the repository.
+// Without a scope, the location Git runs hooks from is used.
+func Locate(run Runner, scope Scope) (string, error) {
+	switch scope {
+	case ScopeGlobal:
+		return locateGlobal(run)
+	case ScopeLocal:
+		return locateLocal(run)
+	}
+
+	if glob, err := locateGlobal(run); err == nil {
+		return glob, nil
+	}
+
+	return locateLocal(run)
+}
 
-func Locate(run Runner) (string, error) {
+func (s Scope) String() string {
+	return [...]string{
+		"",
+		"global",
+		"local",
+	}[s]
+}
+
+func ParseScope(str string) (Scope, error) {
+	s, ok := map[string]Scope{
+		"":       ScopeUnset,
+		"global": ScopeGlobal,
+		"local":  ScopeLocal,
+	}[strings.ToLower(str)]
+	if !ok {
+		return ScopeUnset, fmt.Errorf("%w: %v", ErrScope, str)
+	}
+
+	return s, nil
+}
+
+func locateGlobal(run Runner) (string, error) {
 	glob, _ := runCmd(run, gitCommand, gitGlobalArgs)
+	if glob == "" {
+		return "", ErrLocation
+	}
 
-	if glob != "" {
+	if path.IsAbs(glob) {
 		return glob, nil
 	}
 
-	repo, _ := runCmd(run, gitCommand, gitRepositoryArgs)
+	// Relative paths are resolved from the root of the working tree.
+	top, _ := runCmd(run, gitCommand, gitToplevelArgs)
+	if top == "" {
+		return "", ErrLocation
+	}
 
-	if repo != "" {
-		return repo, nil
+	return path.Join(top, glob), nil
+}
+
+func locateLocal(run Runner) (string, error) {
+	repo, _ := runCmd(run, gitCommand, gitRepositoryArgs)
+	if repo == "" {
+		return "", ErrLocation
 	}
 
-	return "", ErrLocation
+	return path.Join(repo, repositoryHooks), nil
 }
 
 func runCmd(run Runner, cmd string, args []string) (string, error) {
@@ -2,6 +2,7 @@ package hook_test
 
 import (
 	"io"
+	"strings"
 	"testing"
 
 	"github.com/mikelorant/committed/internal/hook"
@@ -10,43 +11,38 @@ import (
 )
 
 type MockLocateRun struct {
-	idx int
-
 	glob    string
 	globErr error
 	repo    string
 	repoErr error
+	top     string
 
-	cmd  string
-	args []string
+	cmds []string
 }
 
 func (r *MockLocateRun) Run() func(io.Writer, string, []string) error {
 	return func(w io.Writer, cmd string, args []string) error {
-		r.cmd = cmd
-		r.args = args
-
-		if r.idx == 0 && r.glob != "" {
-			if r.globErr != nil {
-				return r.globErr
-			}
+		r.cmds = append(r.cmds, strings.Join(append([]string{cmd}, args...), " "))
 
-			io.WriteString(w, r.glob)
+		var (
+			out string
+			err error
+		)
 
-			return nil
+		switch strings.Join(args, " ") {
+		case "config --get core.hooksPath":
+			out, err = r.glob, r.globErr
+		case "rev-parse --absolute-git-dir":
+			out, err = r.repo, r.repoErr
+		case "rev-parse --show-toplevel":
+			out = r.top
 		}
 
-		if r.idx == 1 && r.repo != "" {
-			if r.repoErr != nil {
-				return r.repoErr
-			}
-
-			io.WriteString(w, r.repo)
-
-			return nil
+		if err != nil {
+			return err
 		}
 
-		r.idx++
+		io.WriteString(w, out)
 
 		return nil
 	}
@@ -56,18 +52,19 @@ func TestLocate(t *testing.T) {
 	t.Parallel()
 
 	type args struct {
+		scope hook.Scope
+
 		glob    string
 		globErr error
 
 		repo    string
 		repoErr error
 
-		output string
+		top string
 	}
 
 	type want struct {
-		cmd    string
-		args   []string
+		cmds   []string
 		err    string
 		output string
 	}
@@ -86,29 +83,89 @@ func TestLocate(t *testing.T) {
 		{
 			name: "global",
 			args: args{
-				glob: "test",
+				glob: "/test",
+				repo: "/repo/.git",
+			},
+			want: want{
+				cmds:   []string{"git config --get core.hooksPath"},
+				output: "/test",
+			},
+		},
+		{
+			name: "global_relative",
+			args: args{
+				glob: ".husky",
+				top:  "/repo",
+			},
+			want: want{
+				cmds: []string{
+					"git config --get core.hooksPath",
+					"git rev-parse --show-toplevel",
+				},
+				output: "/repo/.husky",
+			},
+		},
+		{
+			name: "global_relative_no_toplevel",
+			args: args{
+				scope: hook.ScopeGlobal,
+				glob:  ".husky",
 			},
 			want: want{
-				cmd:    "git",
-				args:   []string{"config", "--get", "core.hooksPath"},
-				output: "test",
+				err: "no hook location found",
 			},
 		},
 		{
 			name: "repo",
 			args: args{
-				repo: "test",
+				repo: "/repo/.git",
+			},
+			want: want{
+				cmds: []string{
+					"git config --get core.hooksPath",
+					"git rev-parse --absolute-git-dir",
+				},
+				output: "/repo/.git/hooks",
+			},
+		},
+		{
+			name: "scope_global",
+			args: args{
+				scope: hook.ScopeGlobal,
+				glob:  "/test",
+				repo:  "/repo/.git",
+			},
+			want: want{
+				cmds:   []string{"git config --get core.hooksPath"},
+				output: "/test",
+			},
+		},
+		{
+			name: "scope_global_unset",
+			args: args{
+				scope: hook.ScopeGlobal,
+				repo:  "/repo/.git",
 			},
 			want: want{
-				cmd:    "git",
-				args:   []string{"rev-parse", "--absolute-git-dir"},
-				output: "test",
+				err: "no hook location found",
+			},
+		},
+		{
+			name: "scope_local",
+			args: args{
+				scope: hook.ScopeLocal,
+				glob:  "/test",
+				repo:  "/repo/.git",
+			},
+			want: want{
+				cmds:   []string{"git rev-parse --absolute-git-dir"},
+				output: "/repo/.git/hooks",
 			},
 		},
 		{
 			name: "global_error",
 			args: args{
-				glob:    "test",
+				glob:    "/test",
 				globErr: errMock,
 			},
 			want: want{
@@ -118,7 +175,7 @@ func TestLocate(t *testing.T) {
 		{
 			name: "repo_error",
 			args: args{
-				repo:    "test",
+				repo:    "/repo/.git",
 				repoErr: errMock,
 			},
 			want: want{
@@ -128,34 +185,31 @@ func TestLocate(t *testing.T) {
 		{
 			name: "spaces_at_end",
 			args: args{
-				glob: "test    ",
+				glob: "/test    ",
 			},
 			want: want{
-				cmd:    "git",
-				args:   []string{"config", "--get", "core.hooksPath"},
-				output: "test",
+				cmds:   []string{"git config --get core.hooksPath"},
+				output: "/test",
 			},
 		},
 		{
 			name: "spaces_at_beginning",
 			args: args{
-				glob: "    test",
+				glob: "    /test",
 			},
 			want: want{
-				cmd:    "git",
-				args:   []string{"config", "--get", "core.hooksPath"},
-				output: "test",
+				cmds:   []string{"git config --get core.hooksPath"},
+				output: "/test",
 			},
 		},
 		{
 			name: "spaces_at_beginning_end",
 			args: args{
-				glob: "    test    ",
+				glob: "    /test    ",
 			},
 			want: want{
-				cmd:    "git",
-				args:   []string{"config", "--get", "core.hooksPath"},
-				output: "test",
+				cmds:   []string{"git config --get core.hooksPath"},
+				output: "/test",
 			},
 		},
 	}
@@ -171,9 +225,10 @@ func TestLocate(t *testing.T) {
 				globErr: tt.args.globErr,
 				repo:    tt.args.repo,
 				repoErr: tt.args.repoErr,
+				top:     tt.args.top,
 			}
 
-			got, err := hook.Locate(r.Run())
+			got, err := hook.Locate(r.Run(), tt.args.scope)
 			if tt.want.err != "" {
 				assert.Error(t, err)
 				assert.ErrorContains(t, err, tt.want.err)
@@ -181,9 +236,41 @@ func TestLocate(t *testing.T) {
 			}
 
 			assert.NoError(t, err)
-			assert.Equal(t, tt.want.cmd, r.cmd)
-			assert.Equal(t, tt.want.args, r.args)
+			assert.Equal(t, tt.want.cmds, r.cmds)
 			assert.Equal(t, tt.want.output, got)
 		})
 	}
 }
+
+func TestParseScope(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		scope string
+		want  hook.Scope
+		err   string
+	}{
+		{name: "empty", want: hook.ScopeUnset},
+		{name: "global", scope: "global", want: hook.ScopeGlobal},
+		{name: "local", scope: "Local", want: hook.ScopeLocal},
+		{name: "invalid", scope: "system", err: "invalid hook scope: system"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s, err := hook.ParseScope(tt.scope)
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+				return
+			}
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.want, s)
+		})
+	}
+}
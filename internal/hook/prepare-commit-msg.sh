@@ -11,8 +11,6 @@
 #
 # Source: https://git-scm.com/docs/githooks#_prepare_commit_msg
 
-exec < /dev/tty
-
 # name of the file that contains the commit log message
 : "${message_file:=$1}"
 
@@ -22,16 +20,28 @@ exec < /dev/tty
 # sha of the commit
 : "${sha:=$3}"
 
-declare -a args
+# The message was given with a -m or -F option.
+[[ "${source}" = "message" ]] && exit
+
+# Git sets the editor to ":" when no editor will be opened, such as for a
+# rebase, a cherry-pick or a merge with --no-edit.
+[[ "${GIT_EDITOR}" = ":" ]] && exit
+
+# Leave the message to Git when there is no terminal to attach to.
+{ : < /dev/tty; } 2> /dev/null || exit 0
 
-[[ "$source" = "merge" ]] && exit
-[[ "$source" = "squash" ]] && exit
-[[ "$source" = "template" ]] && exit
+exec < /dev/tty
+
+declare -a args
 
 if [[ -n ${message_file} ]]; then
 		args+=(--message-file "$message_file")
 fi
 
+if [[ -n "${source}" ]]; then
+		args+=(--source "${source}")
+fi
+
 if [[ -n "${sha}" ]]; then
 		args+=(--sha "${sha}")
 fi
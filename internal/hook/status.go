@@ -0,0 +1,114 @@
+package hook
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"path"
+)
+
+// Status is the state of a hook file at a location.
+type Status struct {
+	Scope      Scope
+	Location   string
+	Active     bool
+	Exists     bool
+	Managed    bool
+	Executable bool
+	Outdated   bool
+	Chained    bool
+}
+
+// Status reports on the hooks at each location. Active locations are the ones
+// Git runs hooks from.
+func (h *Hook) Status(opts Options) ([]Status, error) {
+	h.Scope = opts.Scope
+
+	active, err := h.Locater(h.Runner, ScopeUnset)
+	if err != nil {
+		return nil, fmt.Errorf("unable to determine hook location: %w", err)
+	}
+
+	scopes := []Scope{ScopeGlobal, ScopeLocal}
+	if h.Scope != ScopeUnset {
+		scopes = []Scope{h.Scope}
+	}
+
+	scripts := []script{
+		{location: GitHook, content: PrepareGitMessage},
+		{location: ValidateHook, content: CommitMessage},
+	}
+
+	var ss []Status
+
+	for _, scope := range scopes {
+		loc, err := h.Locater(h.Runner, scope)
+		switch {
+		case err == nil:
+		case errors.Is(err, ErrLocation):
+			continue
+		default:
+			return nil, fmt.Errorf("unable to determine hook location: %w", err)
+		}
+
+		for _, s := range scripts {
+			s.location = path.Join(loc, s.location)
+
+			st, err := h.status(s)
+			if err != nil {
+				return nil, fmt.Errorf("unable to get hook status: %w", err)
+			}
+
+			st.Scope = scope
+			st.Active = loc == active
+
+			ss = append(ss, st)
+		}
+	}
+
+	return ss, nil
+}
+
+func (h *Hook) status(s script) (Status, error) {
+	st := Status{
+		Location: s.location,
+	}
+
+	fi, err := h.Stater(s.location)
+	if err != nil {
+		return st, nil
+	}
+
+	st.Exists = true
+	st.Executable = fi.Mode().Perm()&0o111 != 0
+	st.Chained = h.exists(backup(s.location))
+
+	st.Managed, err = h.isManaged(s.location)
+	if err != nil {
+		return st, err
+	}
+
+	if !st.Managed {
+		return st, nil
+	}
+
+	fh, err := h.Opener(s.location)
+	if err != nil {
+		return st, fmt.Errorf("unable to open file: %w", err)
+	}
+	defer fh.Close()
+
+	data, err := io.ReadAll(fh)
+	if err != nil {
+		return st, fmt.Errorf("unable to read file: %w", err)
+	}
+
+	want := s.content
+	if st.Chained {
+		want = chainScript(want)
+	}
+
+	st.Outdated = string(data) != want
+
+	return st, nil
+}
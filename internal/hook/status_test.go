@@ -0,0 +1,177 @@
+package hook_test
+
+import (
+	"os"
+	"path"
+	"testing"
+
+	"github.com/mikelorant/committed/internal/hook"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// MockScopeLocater returns a hooks directory for each scope with the global
+// directory active when it is set.
+func MockScopeLocater(global, local string) func(hook.Runner, hook.Scope) (string, error) {
+	return func(_ hook.Runner, scope hook.Scope) (string, error) {
+		switch {
+		case scope == hook.ScopeLocal:
+			return local, nil
+		case global != "":
+			return global, nil
+		case scope == hook.ScopeGlobal:
+			return "", hook.ErrLocation
+		}
+
+		return local, nil
+	}
+}
+
+func TestStatus(t *testing.T) {
+	t.Parallel()
+
+	type file struct {
+		name string
+		data string
+		perm os.FileMode
+	}
+
+	type args struct {
+		global bool
+		scope  hook.Scope
+		files  []file
+	}
+
+	type want struct {
+		statuses []hook.Status
+	}
+
+	tests := []struct {
+		name string
+		args args
+		want want
+	}{
+		{
+			name: "empty",
+			want: want{
+				statuses: []hook.Status{
+					{Scope: hook.ScopeLocal, Location: "local/prepare-commit-msg", Active: true},
+					{Scope: hook.ScopeLocal, Location: "local/commit-msg", Active: true},
+				},
+			},
+		},
+		{
+			name: "installed",
+			args: args{
+				files: []file{
+					{name: "local/prepare-commit-msg", data: hook.PrepareGitMessage, perm: 0o755},
+					{name: "local/commit-msg", data: hook.Marker, perm: 0o644},
+				},
+			},
+			want: want{
+				statuses: []hook.Status{
+					{
+						Scope: hook.ScopeLocal, Location: "local/prepare-commit-msg", Active: true,
+						Exists: true, Managed: true, Executable: true,
+					},
+					{
+						Scope: hook.ScopeLocal, Location: "local/commit-msg", Active: true,
+						Exists: true, Managed: true, Outdated: true,
+					},
+				},
+			},
+		},
+		{
+			name: "global",
+			args: args{
+				global: true,
+				files: []file{
+					{name: "local/prepare-commit-msg", data: "unmanaged", perm: 0o755},
+					{name: "global/prepare-commit-msg", data: hook.PrepareGitMessage, perm: 0o755},
+				},
+			},
+			want: want{
+				statuses: []hook.Status{
+					{
+						Scope: hook.ScopeGlobal, Location: "global/prepare-commit-msg", Active: true,
+						Exists: true, Managed: true, Executable: true,
+					},
+					{Scope: hook.ScopeGlobal, Location: "global/commit-msg", Active: true},
+					{
+						Scope: hook.ScopeLocal, Location: "local/prepare-commit-msg",
+						Exists: true, Executable: true,
+					},
+					{Scope: hook.ScopeLocal, Location: "local/commit-msg"},
+				},
+			},
+		},
+		{
+			name: "chained",
+			args: args{
+				files: []file{
+					{name: "local/prepare-commit-msg", data: hook.PrepareGitMessage, perm: 0o755},
+					{name: "local/prepare-commit-msg" + hook.BackupSuffix, data: "unmanaged", perm: 0o755},
+				},
+			},
+			want: want{
+				statuses: []hook.Status{
+					{
+						Scope: hook.ScopeLocal, Location: "local/prepare-commit-msg", Active: true,
+						Exists: true, Managed: true, Executable: true, Outdated: true, Chained: true,
+					},
+					{Scope: hook.ScopeLocal, Location: "local/commit-msg", Active: true},
+				},
+			},
+		},
+		{
+			name: "scope",
+			args: args{
+				global: true,
+				scope:  hook.ScopeLocal,
+			},
+			want: want{
+				statuses: []hook.Status{
+					{Scope: hook.ScopeLocal, Location: "local/prepare-commit-msg"},
+					{Scope: hook.ScopeLocal, Location: "local/commit-msg"},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			dir := t.TempDir()
+
+			_ = os.MkdirAll(path.Join(dir, "global"), 0o755)
+			_ = os.MkdirAll(path.Join(dir, "local"), 0o755)
+
+			for _, f := range tt.args.files {
+				_ = os.WriteFile(path.Join(dir, f.name), []byte(f.data), f.perm)
+			}
+
+			var global string
+			if tt.args.global {
+				global = path.Join(dir, "global")
+			}
+
+			h := hook.Hook{
+				Locater: MockScopeLocater(global, path.Join(dir, "local")),
+				Opener:  os.Open,
+				Stater:  os.Stat,
+			}
+
+			ss, err := h.Status(hook.Options{Status: true, Scope: tt.args.scope})
+			assert.NoError(t, err)
+
+			for i := range tt.want.statuses {
+				tt.want.statuses[i].Location = path.Join(dir, tt.want.statuses[i].Location)
+			}
+
+			assert.Equal(t, tt.want.statuses, ss)
+		})
+	}
+}
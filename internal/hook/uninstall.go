@@ -5,8 +5,12 @@ import (
 	"path"
 )
 
+// Uninstall removes the prepare-commit-msg hook and the commit-msg hook if it
+// was installed. Both hooks are checked before anything is removed, so an
+// unmanaged prepare-commit-msg hook leaves the commit-msg hook in place. Any
+// chained hook is restored from its backup.
 func (h *Hook) Uninstall() error {
-	loc, err := h.Locater(h.Runner)
+	loc, err := h.Locater(h.Runner, h.Scope)
 	if err != nil {
 		return fmt.Errorf("unable to determine hook location: %w", err)
 	}
@@ -17,31 +21,53 @@ func (h *Hook) Uninstall() error {
 
 	h.Location = path.Join(loc, GitHook)
 
-	manage, err := h.unmanage()
+	managed, err := h.isManaged(h.Location)
 	if err != nil {
 		return fmt.Errorf("unable to determine managed state: %w", err)
 	}
 
-	if !manage {
-		return ErrUnmanaged
+	validate := path.Join(loc, ValidateHook)
+
+	var validated bool
+
+	if h.exists(validate) {
+		if validated, err = h.isManaged(validate); err != nil {
+			return fmt.Errorf("unable to determine managed state: %w", err)
+		}
+	}
+
+	if !managed {
+		return fmt.Errorf("%w: %v", ErrUnmanaged, h.Location)
+	}
+
+	if err := h.remove(h.Location); err != nil {
+		return fmt.Errorf("unable to remove hook: %w", err)
+	}
+
+	if !validated {
+		return nil
+	}
+
+	if err := h.remove(validate); err != nil {
+		return fmt.Errorf("unable to remove hook: %w", err)
 	}
 
 	return nil
 }
 
-func (h *Hook) unmanage() (bool, error) {
-	managed, err := h.isManaged()
-	if err != nil {
-		return false, err
+// remove deletes a hook and restores the hook it replaced.
+func (h *Hook) remove(location string) error {
+	if err := h.Deleter(location); err != nil {
+		return fmt.Errorf("unable to delete file: %w", err)
 	}
 
-	if !managed {
-		return false, nil
+	if !h.exists(backup(location)) {
+		return nil
 	}
 
-	if err := h.Deleter(h.Location); err != nil {
-		return false, fmt.Errorf("unable to delete file: %w", err)
+	if err := h.Renamer(backup(location), location); err != nil {
+		return fmt.Errorf("unable to restore hook: %w", err)
 	}
 
-	return true, nil
+	return nil
 }
@@ -1,6 +1,7 @@
 package hook_test
 
 import (
+	"os"
 	"path"
 	"testing"
 
@@ -10,13 +11,13 @@ import (
 )
 
 type MockDelete struct {
-	delFile string
-	err     error
+	delFiles []string
+	err      error
 }
 
 func (d *MockDelete) Delete() func(string) error {
 	return func(file string) error {
-		d.delFile = file
+		d.delFiles = append(d.delFiles, path.Base(file))
 
 		if d.err != nil {
 			return d.err
@@ -28,17 +29,19 @@ func (d *MockDelete) Delete() func(string) error {
 
 func TestUninstall(t *testing.T) {
 	type args struct {
-		data     string
-		emptyLoc bool
-		openErr  error
-		locErr   error
-		delErr   error
-		runErr   error
+		data         string
+		validateData string
+		backupData   string
+		emptyLoc     bool
+		openErr      error
+		locErr       error
+		delErr       error
+		runErr       error
 	}
 
 	type want struct {
-		delFile string
-		err     string
+		delFiles []string
+		err      string
 	}
 
 	tests := []struct {
@@ -49,7 +52,7 @@ func TestUninstall(t *testing.T) {
 		{
 			name: "default",
 			want: want{
-				delFile: "prepare-commit-msg",
+				delFiles: []string{"prepare-commit-msg"},
 			},
 		},
 		{
@@ -67,7 +70,37 @@ func TestUninstall(t *testing.T) {
 				data: hook.Marker,
 			},
 			want: want{
-				delFile: "prepare-commit-msg",
+				delFiles: []string{"prepare-commit-msg"},
+			},
+		},
+		{
+			name: "validate_managed",
+			args: args{
+				data:         hook.Marker,
+				validateData: hook.Marker,
+			},
+			want: want{
+				delFiles: []string{"prepare-commit-msg", "commit-msg"},
+			},
+		},
+		{
+			name: "validate_unmanaged",
+			args: args{
+				data:         hook.Marker,
+				validateData: "unmanaged",
+			},
+			want: want{
+				delFiles: []string{"prepare-commit-msg"},
+			},
+		},
+		{
+			name: "unmanaged_validate_managed",
+			args: args{
+				data:         "unmanaged",
+				validateData: hook.Marker,
+			},
+			want: want{
+				err: "hook file unmanaged",
 			},
 		},
 		{
@@ -94,7 +127,8 @@ func TestUninstall(t *testing.T) {
 				delErr: errMock,
 			},
 			want: want{
-				err: "unable to determine managed state: unable to delete file: error",
+				delFiles: []string{"prepare-commit-msg"},
+				err:      "unable to remove hook: unable to delete file: error",
 			},
 		},
 		{
@@ -111,26 +145,115 @@ func TestUninstall(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			del := MockDelete{
+			del := &MockDelete{
 				err: tt.args.delErr,
 			}
 
 			h := hook.Hook{
 				Deleter: del.Delete(),
-				Locater: MockLocater(t, tt.args.emptyLoc, tt.args.data, tt.args.locErr),
-				Opener:  MockOpen(tt.args.openErr),
-				Runner:  MockRun(tt.args.data, tt.args.runErr),
-				Stater:  MockStat(),
+				Locater: MockLocater(t, tt.args.emptyLoc, MockFiles{
+					hook:     tt.args.data,
+					validate: tt.args.validateData,
+					backup:   tt.args.backupData,
+				}, tt.args.locErr),
+				Opener: MockOpen(tt.args.openErr),
+				Runner: MockRun(tt.args.data, tt.args.runErr),
+				Stater: MockStat(),
 			}
 
 			err := h.Uninstall()
 			if tt.want.err != "" {
 				assert.Error(t, err)
 				assert.ErrorContains(t, err, tt.want.err)
+				assert.Equal(t, tt.want.delFiles, del.delFiles)
 				return
 			}
 			assert.NoError(t, err)
-			assert.Equal(t, tt.want.delFile, path.Base(del.delFile))
+			assert.Equal(t, tt.want.delFiles, del.delFiles)
+		})
+	}
+}
+
+func TestUninstallChain(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		data       string
+		backupData string
+		renameErr  error
+	}
+
+	type want struct {
+		data string
+		err  string
+	}
+
+	tests := []struct {
+		name string
+		args args
+		want want
+	}{
+		{
+			name: "restore",
+			args: args{
+				data:       hook.Marker,
+				backupData: "unmanaged",
+			},
+			want: want{
+				data: "unmanaged",
+			},
+		},
+		{
+			name: "unmanaged",
+			args: args{
+				data:       "unmanaged",
+				backupData: "backup",
+			},
+			want: want{
+				err: "hook file unmanaged",
+			},
+		},
+		{
+			name: "rename_error",
+			args: args{
+				data:       hook.Marker,
+				backupData: "unmanaged",
+				renameErr:  errMock,
+			},
+			want: want{
+				err: "unable to remove hook: unable to restore hook: error",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			h := hook.Hook{
+				Deleter: os.Remove,
+				Opener:  os.Open,
+				Renamer: MockRename(tt.args.renameErr),
+				Locater: MockLocater(t, false, MockFiles{
+					hook:   tt.args.data,
+					backup: tt.args.backupData,
+				}, nil),
+				Runner: MockRun("", nil),
+				Stater: os.Stat,
+			}
+
+			err := h.Uninstall()
+			if tt.want.err != "" {
+				assert.Error(t, err)
+				assert.ErrorContains(t, err, tt.want.err)
+				return
+			}
+			assert.NoError(t, err)
+
+			data, _ := os.ReadFile(h.Location)
+			assert.Equal(t, tt.want.data, string(data))
 		})
 	}
 }
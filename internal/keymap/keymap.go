@@ -0,0 +1,353 @@
+package keymap
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// Action is a command that can be bound to keys.
+type Action string
+
+// Global actions can be used within any view.
+const (
+	Commit        Action = "commit"
+	Amend         Action = "amend"
+	Load          Action = "load"
+	Signoff       Action = "signoff"
+	Breaking      Action = "breaking"
+	Theme         Action = "theme"
+	Editor        Action = "editor"
+	Reflow        Action = "reflow"
+	Undo          Action = "undo"
+	Redo          Action = "redo"
+	Help          Action = "help"
+	FocusAuthor   Action = "focusAuthor"
+	FocusEmoji    Action = "focusEmoji"
+	FocusSummary  Action = "focusSummary"
+	FocusBody     Action = "focusBody"
+	FocusTrailers Action = "focusTrailers"
+	Cancel        Action = "cancel"
+	Next          Action = "next"
+	Previous      Action = "previous"
+)
+
+// Emoji and trailer actions are limited to their views.
+const (
+	PinEmoji    Action = "pinEmoji"
+	ClearEmoji  Action = "clearEmoji"
+	ResetFilter Action = "resetFilter"
+	NextPage    Action = "nextPage"
+	PrevPage    Action = "previousPage"
+	EditTrailer Action = "editTrailer"
+	DropTrailer Action = "removeTrailer"
+	TrailerKey  Action = "trailerKey"
+	Select      Action = "selectTrailer"
+	CoAuthors   Action = "coAuthors"
+)
+
+// Group is the view in which a binding can be used.
+type Group int
+
+const (
+	GroupGlobal Group = iota
+	GroupEmoji
+	GroupTrailers
+)
+
+// Binding binds keys to an action. The first key is shown in the help and
+// the shortcuts. Fixed bindings are handled by the views and cannot be
+// changed.
+type Binding struct {
+	Action   Action
+	Keys     []string
+	Label    string
+	Shortcut string
+	Hint     string
+	Group    Group
+	Fixed    bool
+}
+
+// Keymap is the key bindings of every action. The zero value uses the
+// default bindings.
+type Keymap struct {
+	bindings []Binding
+}
+
+var (
+	ErrAction   = errors.New("unknown action")
+	ErrFixed    = errors.New("action cannot be changed")
+	ErrKeys     = errors.New("no keys for action")
+	ErrConflict = errors.New("key bound to more than one action")
+)
+
+// Keys on macOS produced by the option key when it is not used as alt.
+const (
+	macAmend    = "å"
+	macLoad     = "¬"
+	macSignoff  = "ß"
+	macBreaking = "∫"
+	macTheme    = "†"
+	macReflow   = "œ"
+	macAuthor   = "¡"
+	macEmoji    = "™"
+	macSummary  = "£"
+	macBody     = "¢"
+	macTrailers = "∞"
+	macHelp     = "˙"
+)
+
+func defaults() []Binding {
+	return []Binding{
+		{Action: Commit, Keys: []string{"alt+enter", "alt+\\"}, Label: "Commit", Shortcut: "Commit"},
+		{Action: Amend, Keys: []string{"alt+a", macAmend}, Label: "Toggle amend", Shortcut: "Amend"},
+		{Action: Load, Keys: []string{"alt+l", macLoad}, Label: "Load saved", Shortcut: "Load"},
+		{Action: Signoff, Keys: []string{"alt+s", macSignoff}, Label: "Toggle sign-off", Shortcut: "Sign-off"},
+		{Action: Breaking, Keys: []string{"alt+b", macBreaking}, Label: "Toggle breaking"},
+		{Action: Theme, Keys: []string{"alt+t", macTheme}, Label: "Toggle theme"},
+		{Action: Editor, Keys: []string{"alt+e"}, Label: "Open editor"},
+		{Action: Reflow, Keys: []string{"alt+q", macReflow}, Label: "Reflow paragraph"},
+		{Action: Undo, Keys: []string{"ctrl+z"}, Label: "Undo"},
+		{Action: Redo, Keys: []string{"ctrl+y"}, Label: "Redo"},
+		{Action: Cancel, Keys: []string{"ctrl+c"}, Label: "Cancel", Shortcut: "Cancel"},
+		{Action: Help, Keys: []string{"ctrl+h", macHelp}, Label: "Help", Shortcut: "Help"},
+		{Action: FocusAuthor, Keys: []string{"alt+1", macAuthor}, Label: "Focus author"},
+		{Action: FocusEmoji, Keys: []string{"alt+2", macEmoji}, Label: "Focus emoji"},
+		{Action: FocusSummary, Keys: []string{"alt+3", macSummary}, Label: "Focus summary"},
+		{Action: FocusBody, Keys: []string{"alt+4", macBody}, Label: "Focus body"},
+		{Action: FocusTrailers, Keys: []string{"alt+5", macTrailers}, Label: "Focus trailers"},
+		{Action: Next, Keys: []string{"tab"}, Label: "Next component"},
+		{Action: Previous, Keys: []string{"shift+tab"}, Label: "Previous component"},
+
+		{Action: ClearEmoji, Keys: []string{"delete"}, Label: "Clear emoji", Group: GroupEmoji, Fixed: true},
+		{Action: ResetFilter, Keys: []string{"esc"}, Label: "Reset filter", Hint: "escape", Group: GroupEmoji, Fixed: true},
+		{Action: PinEmoji, Keys: []string{"ctrl+p"}, Label: "Pin favourite", Group: GroupEmoji},
+		{Action: NextPage, Keys: []string{"pgdown"}, Label: "Next page", Hint: "page down", Group: GroupEmoji, Fixed: true},
+		{Action: PrevPage, Keys: []string{"pgup"}, Label: "Previous page", Hint: "page up", Group: GroupEmoji, Fixed: true},
+
+		{Action: EditTrailer, Keys: []string{"enter"}, Label: "Add or edit", Group: GroupTrailers, Fixed: true},
+		{Action: DropTrailer, Keys: []string{"delete", "backspace"}, Label: "Remove", Group: GroupTrailers, Fixed: true},
+		{Action: TrailerKey, Keys: []string{"ctrl+t"}, Label: "Change key", Group: GroupTrailers},
+		{Action: Select, Keys: []string{"up", "down"}, Label: "Select", Hint: "up/down", Group: GroupTrailers, Fixed: true},
+		{Action: CoAuthors, Keys: []string{"ctrl+a"}, Label: "Co-authors", Group: GroupTrailers},
+	}
+}
+
+// New returns the default key bindings with the keys of actions replaced.
+// Global keys must not be bound to any other action as they are handled
+// before the views.
+func New(keys map[string][]string) (Keymap, error) {
+	if len(keys) == 0 {
+		return Keymap{}, nil
+	}
+
+	bs := defaults()
+
+	for name, ks := range keys {
+		i := index(bs, Action(name))
+
+		switch {
+		case i < 0:
+			return Keymap{}, fmt.Errorf("%w: %v", ErrAction, name)
+		case bs[i].Fixed:
+			return Keymap{}, fmt.Errorf("%w: %v", ErrFixed, name)
+		case len(ks) == 0:
+			return Keymap{}, fmt.Errorf("%w: %v", ErrKeys, name)
+		}
+
+		bs[i].Keys = ks
+	}
+
+	if err := conflicts(bs); err != nil {
+		return Keymap{}, err
+	}
+
+	return Keymap{bindings: bs}, nil
+}
+
+// Actions returns the names of the actions that can be bound.
+func Actions() []string {
+	var as []string
+
+	for _, b := range defaults() {
+		if !b.Fixed {
+			as = append(as, string(b.Action))
+		}
+	}
+
+	return as
+}
+
+// Bindings returns the bindings of a group.
+func (k Keymap) Bindings(g Group) []Binding {
+	var bs []Binding
+
+	for _, b := range k.all() {
+		if b.Group == g {
+			bs = append(bs, b)
+		}
+	}
+
+	return bs
+}
+
+// Action returns the global action bound to a key.
+func (k Keymap) Action(key string) (Action, bool) {
+	for _, b := range k.Bindings(GroupGlobal) {
+		if contains(b.Keys, key) {
+			return b.Action, true
+		}
+	}
+
+	return "", false
+}
+
+// Matches reports if a key is bound to an action.
+func (k Keymap) Matches(key string, a Action) bool {
+	i := index(k.all(), a)
+
+	return i >= 0 && contains(k.all()[i].Keys, key)
+}
+
+// Key returns the key shown for an action.
+func (k Keymap) Key(a Action) string {
+	i := index(k.all(), a)
+	if i < 0 {
+		return ""
+	}
+
+	return k.all()[i].Key()
+}
+
+// Key returns the key shown for the binding.
+func (b Binding) Key() string {
+	if b.Hint != "" {
+		return b.Hint
+	}
+
+	return b.Keys[0]
+}
+
+// Help returns the help text listing the global bindings beside the emoji
+// and trailer bindings.
+func (k Keymap) Help() string {
+	global := k.Bindings(GroupGlobal)
+	emoji, trailers := k.Bindings(GroupEmoji), k.Bindings(GroupTrailers)
+
+	left := column("Global", global, labelWidth(global))
+
+	// The emoji and trailer bindings share a column.
+	rw := labelWidth(append(emoji[:len(emoji):len(emoji)], trailers...))
+	right := column("Emoji", emoji, rw)
+	right = append(right, "")
+	right = append(right, column("Trailers", trailers, rw)...)
+
+	width := 0
+	for _, l := range left {
+		if n := utf8.RuneCountInString(l); n > width {
+			width = n
+		}
+	}
+
+	var ls []string
+
+	for i := 0; i < len(left) || i < len(right); i++ {
+		var l, r string
+
+		if i < len(left) {
+			l = left[i]
+		}
+
+		if i < len(right) {
+			r = right[i]
+		}
+
+		if r != "" {
+			l = pad(l, width+gutter)
+		}
+
+		ls = append(ls, strings.TrimRight(l+r, " "))
+	}
+
+	return strings.Join(ls, "\n") + "\n"
+}
+
+const gutter = 3
+
+// column lists the label and key of each binding beneath a title.
+func column(title string, bs []Binding, width int) []string {
+	ls := []string{title, ""}
+
+	for _, b := range bs {
+		ls = append(ls, pad(b.Label, width+gutter)+b.Key())
+	}
+
+	return ls
+}
+
+func labelWidth(bs []Binding) int {
+	width := 0
+	for _, b := range bs {
+		if n := utf8.RuneCountInString(b.Label); n > width {
+			width = n
+		}
+	}
+
+	return width
+}
+
+func (k Keymap) all() []Binding {
+	if k.bindings == nil {
+		return defaults()
+	}
+
+	return k.bindings
+}
+
+// conflicts reports a global key that is bound to more than one action.
+func conflicts(bs []Binding) error {
+	for i, b := range bs {
+		if b.Group != GroupGlobal {
+			continue
+		}
+
+		for _, key := range b.Keys {
+			for j, o := range bs {
+				if i != j && contains(o.Keys, key) {
+					return fmt.Errorf("%w: %v: %v, %v", ErrConflict, key, b.Action, o.Action)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func pad(s string, width int) string {
+	if n := width - utf8.RuneCountInString(s); n > 0 {
+		return s + strings.Repeat(" ", n)
+	}
+
+	return s
+}
+
+func index(bs []Binding, a Action) int {
+	for i, b := range bs {
+		if b.Action == a {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func contains(ss []string, s string) bool {
+	for _, v := range ss {
+		if v == s {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,205 @@
+package keymap_test
+
+import (
+	"testing"
+
+	"github.com/mikelorant/committed/internal/keymap"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	type want struct {
+		keys map[keymap.Action]string
+		err  string
+	}
+
+	tests := []struct {
+		name string
+		keys map[string][]string
+		want want
+	}{
+		{
+			name: "default",
+			want: want{
+				keys: map[keymap.Action]string{
+					keymap.Commit:    "alt+enter",
+					keymap.Help:      "ctrl+h",
+					keymap.PinEmoji:  "ctrl+p",
+					keymap.NextPage:  "page down",
+					keymap.Previous:  "shift+tab",
+					keymap.CoAuthors: "ctrl+a",
+				},
+			},
+		},
+		{
+			name: "replaced",
+			keys: map[string][]string{
+				"commit":    {"ctrl+s", "alt+enter"},
+				"help":      {"f1"},
+				"coAuthors": {"ctrl+o"},
+			},
+			want: want{
+				keys: map[keymap.Action]string{
+					keymap.Commit:    "ctrl+s",
+					keymap.Help:      "f1",
+					keymap.Amend:     "alt+a",
+					keymap.CoAuthors: "ctrl+o",
+				},
+			},
+		},
+		{
+			name: "swapped",
+			keys: map[string][]string{
+				"next":     {"shift+tab"},
+				"previous": {"tab"},
+			},
+			want: want{
+				keys: map[keymap.Action]string{
+					keymap.Next:     "shift+tab",
+					keymap.Previous: "tab",
+				},
+			},
+		},
+		{
+			name: "unknown_action",
+			keys: map[string][]string{"comit": {"ctrl+s"}},
+			want: want{err: "unknown action: comit"},
+		},
+		{
+			name: "fixed_action",
+			keys: map[string][]string{"clearEmoji": {"ctrl+d"}},
+			want: want{err: "action cannot be changed: clearEmoji"},
+		},
+		{
+			name: "no_keys",
+			keys: map[string][]string{"commit": {}},
+			want: want{err: "no keys for action: commit"},
+		},
+		{
+			name: "global_conflict",
+			keys: map[string][]string{"commit": {"alt+a"}},
+			want: want{err: "key bound to more than one action: alt+a: commit, amend"},
+		},
+		{
+			name: "view_conflict",
+			keys: map[string][]string{"help": {"ctrl+p"}},
+			want: want{err: "key bound to more than one action: ctrl+p: help, pinEmoji"},
+		},
+		{
+			name: "fixed_conflict",
+			keys: map[string][]string{"cancel": {"esc"}},
+			want: want{err: "key bound to more than one action: esc: cancel, resetFilter"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			km, err := keymap.New(tt.keys)
+			if tt.want.err != "" {
+				assert.EqualError(t, err, tt.want.err)
+				return
+			}
+			assert.NoError(t, err)
+
+			for a, key := range tt.want.keys {
+				assert.Equal(t, key, km.Key(a), a)
+			}
+		})
+	}
+}
+
+func TestAction(t *testing.T) {
+	t.Parallel()
+
+	km, err := keymap.New(map[string][]string{"help": {"f1", "ctrl+h"}})
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		key    string
+		action keymap.Action
+		ok     bool
+	}{
+		{name: "first_key", key: "f1", action: keymap.Help, ok: true},
+		{name: "second_key", key: "ctrl+h", action: keymap.Help, ok: true},
+		{name: "default_key", key: "alt+\\", action: keymap.Commit, ok: true},
+		{name: "option_key", key: "å", action: keymap.Amend, ok: true},
+		{name: "replaced_key", key: "˙"},
+		{name: "view_key", key: "ctrl+p"},
+		{name: "unbound", key: "x"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			a, ok := km.Action(tt.key)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.action, a)
+		})
+	}
+}
+
+func TestMatches(t *testing.T) {
+	t.Parallel()
+
+	var km keymap.Keymap
+
+	assert.True(t, km.Matches("ctrl+p", keymap.PinEmoji))
+	assert.True(t, km.Matches("backspace", keymap.DropTrailer))
+	assert.False(t, km.Matches("ctrl+p", keymap.CoAuthors))
+	assert.False(t, km.Matches("ctrl+p", keymap.Action("unknown")))
+}
+
+func TestActions(t *testing.T) {
+	t.Parallel()
+
+	as := keymap.Actions()
+
+	assert.Contains(t, as, "commit")
+	assert.Contains(t, as, "pinEmoji")
+	assert.NotContains(t, as, "clearEmoji")
+}
+
+func TestHelp(t *testing.T) {
+	t.Parallel()
+
+	km, err := keymap.New(map[string][]string{"commit": {"ctrl+s"}, "trailerKey": {"ctrl+k"}})
+	assert.NoError(t, err)
+
+	want := heredoc.Doc(`
+		Global                           Emoji
+
+		Commit               ctrl+s      Clear emoji     delete
+		Toggle amend         alt+a       Reset filter    escape
+		Load saved           alt+l       Pin favourite   ctrl+p
+		Toggle sign-off      alt+s       Next page       page down
+		Toggle breaking      alt+b       Previous page   page up
+		Toggle theme         alt+t
+		Open editor          alt+e       Trailers
+		Reflow paragraph     alt+q
+		Undo                 ctrl+z      Add or edit     enter
+		Redo                 ctrl+y      Remove          delete
+		Cancel               ctrl+c      Change key      ctrl+k
+		Help                 ctrl+h      Select          up/down
+		Focus author         alt+1       Co-authors      ctrl+a
+		Focus emoji          alt+2
+		Focus summary        alt+3
+		Focus body           alt+4
+		Focus trailers       alt+5
+		Next component       tab
+		Previous component   shift+tab
+	`)
+
+	assert.Equal(t, want, km.Help())
+}
@@ -0,0 +1,198 @@
+package lint
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"unicode"
+
+	"github.com/mikelorant/committed/internal/commit"
+	"github.com/mikelorant/committed/internal/config"
+	"github.com/mikelorant/committed/internal/repository"
+)
+
+// Check lints commit messages read from a file or a revision range.
+type Check struct {
+	Configer    Configer
+	Opener      Opener
+	ReadFiler   ReadFiler
+	Repoer      Repoer
+	Rooter      commit.Rooter
+	GitConfiger commit.GitConfiger
+	Environer   commit.Environer
+}
+
+type (
+	Opener    func(string) (io.Reader, error)
+	ReadFiler func(string) ([]byte, error)
+)
+
+type Configer interface {
+	Merge(config.Options, ...config.Input) (config.Config, config.Sources, error)
+}
+
+type Repoer interface {
+	Open() error
+	Revisions(string) ([]repository.Revision, error)
+}
+
+type Options struct {
+	ConfigFile string
+	File       string
+	Revision   string
+}
+
+// Report is the results of linting a single message.
+type Report struct {
+	Name    string
+	Subject string
+	File    bool
+	Results []Result
+}
+
+const (
+	defaultRevision = "HEAD"
+	shortHashLength = 7
+	scissors        = "# ------------------------ >8 ------------------------"
+)
+
+func NewCheck() Check {
+	return Check{
+		Configer:    new(config.Config),
+		Opener:      commit.FileOpen(),
+		ReadFiler:   os.ReadFile,
+		Repoer:      repository.New(),
+		Rooter:      commit.WorktreeRoot,
+		GitConfiger: commit.GitConfigs,
+		Environer:   os.Environ,
+	}
+}
+
+func (c *Check) Do(opts Options) ([]Report, error) {
+	cfg, err := c.config(opts.ConfigFile)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get config: %w", err)
+	}
+
+	l := New(cfg)
+
+	if opts.File != "" {
+		data, err := c.ReadFiler(opts.File)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read message file: %w", err)
+		}
+
+		msg, lines := trimComments(string(data))
+
+		r := report(l, opts.File, msg)
+		r.File = true
+		r.Results = mapLines(r.Results, lines)
+
+		return []Report{r}, nil
+	}
+
+	if err := c.Repoer.Open(); err != nil {
+		return nil, fmt.Errorf("unable to open repository: %w", err)
+	}
+
+	rev := opts.Revision
+	if rev == "" {
+		rev = defaultRevision
+	}
+
+	revs, err := c.Repoer.Revisions(rev)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get revisions: %w", err)
+	}
+
+	var rs []Report
+
+	for _, r := range revs {
+		// Merge commits have generated messages that are not linted.
+		if r.Merge {
+			continue
+		}
+
+		rs = append(rs, report(l, shortHash(r.Hash), r.Message))
+	}
+
+	return rs, nil
+}
+
+// TrimComments removes the comments and any diff appended by Git to a
+// message file.
+func TrimComments(msg string) string {
+	msg, _ = trimComments(msg)
+
+	return msg
+}
+
+// trimComments removes the comments and any diff from a message file and
+// returns the line number in the file of each line that remains.
+func trimComments(msg string) (string, []int) {
+	var (
+		ls []string
+		ns []int
+	)
+
+	for i, l := range strings.Split(msg, "\n") {
+		if l == scissors {
+			break
+		}
+
+		if isComment(l) {
+			continue
+		}
+
+		ls = append(ls, l)
+		ns = append(ns, i+1)
+	}
+
+	msg = strings.Join(ls, "\n")
+	lead := len(msg) - len(strings.TrimLeftFunc(msg, unicode.IsSpace))
+
+	return strings.TrimSpace(msg), ns[strings.Count(msg[:lead], "\n"):]
+}
+
+// mapLines replaces the line numbers of results with the line numbers in the
+// message file.
+func mapLines(rs []Result, lines []int) []Result {
+	for i, r := range rs {
+		if n := r.Problem.Line; n > 0 && n <= len(lines) {
+			rs[i].Problem.Line = lines[n-1]
+		}
+	}
+
+	return rs
+}
+
+func (c *Check) config(file string) (config.Config, error) {
+	ins, err := commit.ConfigInputs(commit.Opener(c.Opener), c.Rooter, c.GitConfiger, c.Environer, file, nil)
+	if err != nil {
+		return config.Config{}, err
+	}
+
+	cfg, _, err := c.Configer.Merge(commit.ConfigOptions(false), ins...)
+	if err != nil {
+		return config.Config{}, fmt.Errorf("unable to load config file: %w", err)
+	}
+
+	return cfg, nil
+}
+
+func report(l Linter, name, msg string) Report {
+	return Report{
+		Name:    name,
+		Subject: Parse(msg).Subject,
+		Results: l.Lint(msg),
+	}
+}
+
+func shortHash(h string) string {
+	if len(h) < shortHashLength {
+		return h
+	}
+
+	return h[:shortHashLength]
+}
@@ -0,0 +1,287 @@
+package lint_test
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/mikelorant/committed/internal/config"
+	"github.com/mikelorant/committed/internal/lint"
+	"github.com/mikelorant/committed/internal/repository"
+
+	gitconfig "github.com/go-git/go-git/v5/plumbing/format/config"
+	"github.com/stretchr/testify/assert"
+)
+
+type MockRepo struct {
+	revisions []repository.Revision
+	openErr   error
+	revErr    error
+	rev       string
+}
+
+func (m *MockRepo) Open() error {
+	return m.openErr
+}
+
+func (m *MockRepo) Revisions(rev string) ([]repository.Revision, error) {
+	m.rev = rev
+
+	return m.revisions, m.revErr
+}
+
+var errMock = errors.New("error")
+
+func mockOpener(str string, err error) lint.Opener {
+	return func(string) (io.Reader, error) {
+		return strings.NewReader(str), err
+	}
+}
+
+func mockRooter() (string, error) {
+	return "", nil
+}
+
+func mockGitConfiger(string) ([]*gitconfig.Config, error) {
+	return nil, nil
+}
+
+func mockEnvironer() []string {
+	return nil
+}
+
+func mockReadFiler(str string, err error) lint.ReadFiler {
+	return func(string) ([]byte, error) {
+		return []byte(str), err
+	}
+}
+
+func TestCheck(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		opts      lint.Options
+		config    string
+		configErr error
+		file      string
+		fileErr   error
+		revisions []repository.Revision
+		openErr   error
+		revErr    error
+	}
+
+	type want struct {
+		reports []lint.Report
+		rev     string
+		err     string
+	}
+
+	tests := []struct {
+		name string
+		args args
+		want want
+	}{
+		{
+			name: "file",
+			args: args{
+				opts: lint.Options{File: ".git/COMMIT_EDITMSG"},
+				file: "Summary.\n# Please enter the commit message.\n",
+			},
+			want: want{
+				reports: []lint.Report{
+					{
+						Name:    ".git/COMMIT_EDITMSG",
+						Subject: "Summary.",
+						File:    true,
+						Results: []lint.Result{
+							{
+								Rule:     "summary-period",
+								Severity: config.SeverityWarning,
+								Problem:  lint.Problem{Line: 1, Column: 8, Message: "Summary should not end with a period."},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "file_comment_lines",
+			args: args{
+				opts: lint.Options{File: ".git/COMMIT_EDITMSG"},
+				file: "# Please enter the commit message.\nSummary\n# Lines starting with '#' will be ignored.\nbody\n",
+			},
+			want: want{
+				reports: []lint.Report{
+					{
+						Name:    ".git/COMMIT_EDITMSG",
+						Subject: "Summary",
+						File:    true,
+						Results: []lint.Result{
+							{
+								Rule:     "blank-line",
+								Severity: config.SeverityError,
+								Problem:  lint.Problem{Line: 4, Column: 1, Message: "Summary should be followed by a blank line."},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "file_config",
+			args: args{
+				opts:   lint.Options{File: ".git/COMMIT_EDITMSG", ConfigFile: "config.yaml"},
+				config: "lint:\n  summaryPeriod: off\n",
+				file:   "Summary.",
+			},
+			want: want{
+				reports: []lint.Report{
+					{
+						Name:    ".git/COMMIT_EDITMSG",
+						Subject: "Summary.",
+						File:    true,
+					},
+				},
+			},
+		},
+		{
+			name: "revisions",
+			args: args{
+				opts: lint.Options{Revision: "main..HEAD"},
+				revisions: []repository.Revision{
+					{Hash: "4f3c7d8e9a0b1c2d3e4f5a6b7c8d9e0f1a2b3c4d", Message: "Merge branch 'main'", Merge: true},
+					{Hash: "0a1b2c3d4e5f6a7b8c9d0e1f2a3b4c5d6e7f8a9b", Message: "Summary\nbody\n"},
+				},
+			},
+			want: want{
+				reports: []lint.Report{
+					{
+						Name:    "0a1b2c3",
+						Subject: "Summary",
+						Results: []lint.Result{
+							{
+								Rule:     "blank-line",
+								Severity: config.SeverityError,
+								Problem:  lint.Problem{Line: 2, Column: 1, Message: "Summary should be followed by a blank line."},
+							},
+						},
+					},
+				},
+				rev: "main..HEAD",
+			},
+		},
+		{
+			name: "revisions_default",
+			want: want{
+				rev: "HEAD",
+			},
+		},
+		{
+			name: "config_error",
+			args: args{
+				opts:      lint.Options{ConfigFile: "config.yaml"},
+				configErr: errMock,
+			},
+			want: want{
+				err: "unable to get config: unable to open config file: config.yaml: error",
+			},
+		},
+		{
+			name: "file_error",
+			args: args{
+				opts:    lint.Options{File: ".git/COMMIT_EDITMSG"},
+				fileErr: errMock,
+			},
+			want: want{
+				err: "unable to read message file: error",
+			},
+		},
+		{
+			name: "open_error",
+			args: args{
+				openErr: errMock,
+			},
+			want: want{
+				err: "unable to open repository: error",
+			},
+		},
+		{
+			name: "revisions_error",
+			args: args{
+				revErr: errMock,
+			},
+			want: want{
+				err: "unable to get revisions: error",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := MockRepo{
+				revisions: tt.args.revisions,
+				openErr:   tt.args.openErr,
+				revErr:    tt.args.revErr,
+			}
+
+			c := lint.Check{
+				Configer:    new(config.Config),
+				Opener:      mockOpener(tt.args.config, tt.args.configErr),
+				ReadFiler:   mockReadFiler(tt.args.file, tt.args.fileErr),
+				Repoer:      &repo,
+				Rooter:      mockRooter,
+				GitConfiger: mockGitConfiger,
+				Environer:   mockEnvironer,
+			}
+
+			rs, err := c.Do(tt.args.opts)
+			if tt.want.err != "" {
+				assert.EqualError(t, err, tt.want.err)
+				return
+			}
+			assert.Nil(t, err)
+
+			assert.Equal(t, tt.want.reports, rs)
+			assert.Equal(t, tt.want.rev, repo.rev)
+		})
+	}
+}
+
+func TestTrimComments(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		message string
+		want    string
+	}{
+		{
+			name:    "comments",
+			message: "summary\n\n# comment\nbody\n# comment\n",
+			want:    "summary\n\nbody",
+		},
+		{
+			name:    "scissors",
+			message: "summary\n\n# ------------------------ >8 ------------------------\ndiff --git a/file b/file\n",
+			want:    "summary",
+		},
+		{
+			name: "empty",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, lint.TrimComments(tt.message))
+		})
+	}
+}
@@ -0,0 +1,155 @@
+package lint
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/mikelorant/committed/internal/commit"
+	"github.com/mikelorant/committed/internal/config"
+)
+
+// Rule checks a commit message and reports any problems found.
+type Rule struct {
+	Name     string
+	Default  config.Severity
+	Severity func(config.Lint) config.Severity
+	Check    func(Message, Linter) []Problem
+}
+
+// Problem is a single issue reported by a rule.
+type Problem struct {
+	Line    int
+	Column  int
+	Message string
+}
+
+// Result is a problem with the rule and severity that reported it.
+type Result struct {
+	Rule     string
+	Severity config.Severity
+	Problem
+}
+
+// Message is a commit message split into lines.
+type Message struct {
+	Subject string
+	Lines   []string
+}
+
+// Linter checks messages with the rules. Forbidden words are compiled once
+// when the linter is created.
+type Linter struct {
+	Rules  []Rule
+	config config.Lint
+	style  config.Style
+	types  []string
+	words  []word
+}
+
+// word is a forbidden word with the pattern that matches it.
+type word struct {
+	text string
+	re   *regexp.Regexp
+}
+
+func New(cfg config.Config) Linter {
+	return Linter{
+		Rules:  Rules(),
+		config: cfg.Lint,
+		style:  cfg.Commit.Style,
+		types:  commit.Types(cfg.Commit),
+		words:  compileWords(cfg.Lint.Words),
+	}
+}
+
+func (l Linter) Lint(msg string) []Result {
+	var res []Result
+
+	m := Parse(msg)
+
+	for _, r := range l.Rules {
+		sev := r.severity(l.config)
+		if sev == config.SeverityOff {
+			continue
+		}
+
+		for _, p := range r.Check(m, l) {
+			res = append(res, Result{
+				Rule:     r.Name,
+				Severity: sev,
+				Problem:  p,
+			})
+		}
+	}
+
+	return sortResults(res)
+}
+
+func Parse(msg string) Message {
+	msg = strings.TrimRight(msg, "\n")
+	lines := strings.Split(msg, "\n")
+
+	return Message{
+		Subject: lines[0],
+		Lines:   lines,
+	}
+}
+
+func HasErrors(rs []Result) bool {
+	for _, r := range rs {
+		if r.Severity == config.SeverityError {
+			return true
+		}
+	}
+
+	return false
+}
+
+func Count(rs []Result, sev config.Severity) int {
+	var n int
+
+	for _, r := range rs {
+		if r.Severity == sev {
+			n++
+		}
+	}
+
+	return n
+}
+
+// conventional reports if a subject starts with a configured type when the
+// conventional style is used.
+func (l Linter) conventional(subject string) bool {
+	return l.style == config.StyleConventional && commit.MessageToConventional(subject, l.types).Type != ""
+}
+
+func (r Rule) severity(cfg config.Lint) config.Severity {
+	if r.Severity == nil {
+		return r.Default
+	}
+
+	if sev := r.Severity(cfg); sev != config.SeverityUnset {
+		return sev
+	}
+
+	return r.Default
+}
+
+// sortResults orders errors before warnings while keeping rule order.
+func sortResults(rs []Result) []Result {
+	if len(rs) == 0 {
+		return nil
+	}
+
+	res := make([]Result, 0, len(rs))
+
+	for _, sev := range []config.Severity{config.SeverityError, config.SeverityWarning} {
+		for _, r := range rs {
+			if r.Severity == sev {
+				res = append(res, r)
+			}
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,295 @@
+package lint_test
+
+import (
+	"testing"
+
+	"github.com/mikelorant/committed/internal/config"
+	"github.com/mikelorant/committed/internal/lint"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLint(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		message string
+		config  config.Lint
+		commit  config.Commit
+	}
+
+	tests := []struct {
+		name string
+		args args
+		want []lint.Result
+	}{
+		{
+			name: "valid",
+			args: args{
+				message: ":art: Summary\n\nBody.\n",
+			},
+		},
+		{
+			name: "empty",
+		},
+		{
+			name: "summary_length",
+			args: args{
+				message: ":art: Summary that is far too long and will exceed the limit",
+			},
+			want: []lint.Result{
+				{
+					Rule:     "summary-length",
+					Severity: config.SeverityWarning,
+					Problem:  lint.Problem{Line: 1, Column: 57, Message: "Summary is 54 characters, limit is 50."},
+				},
+			},
+		},
+		{
+			name: "summary_length_limit",
+			args: args{
+				message: "Summary",
+				config:  config.Lint{SummaryLimit: 5},
+			},
+			want: []lint.Result{
+				{
+					Rule:     "summary-length",
+					Severity: config.SeverityWarning,
+					Problem:  lint.Problem{Line: 1, Column: 6, Message: "Summary is 7 characters, limit is 5."},
+				},
+			},
+		},
+		{
+			name: "summary_period",
+			args: args{
+				message: "Summary.",
+			},
+			want: []lint.Result{
+				{
+					Rule:     "summary-period",
+					Severity: config.SeverityWarning,
+					Problem:  lint.Problem{Line: 1, Column: 8, Message: "Summary should not end with a period."},
+				},
+			},
+		},
+		{
+			name: "summary_capital",
+			args: args{
+				message: "🎨 summary",
+			},
+			want: []lint.Result{
+				{
+					Rule:     "summary-capital",
+					Severity: config.SeverityWarning,
+					Problem:  lint.Problem{Line: 1, Column: 3, Message: "Summary should start with a capital letter."},
+				},
+			},
+		},
+		{
+			name: "summary_capital_conventional",
+			args: args{
+				message: "feat(api): summary",
+				commit:  config.Commit{Style: config.StyleConventional},
+			},
+		},
+		{
+			name: "summary_capital_conventional_emoji",
+			args: args{
+				message: ":sparkles: feat!: summary",
+				commit:  config.Commit{Style: config.StyleConventional},
+			},
+		},
+		{
+			name: "summary_capital_conventional_types",
+			args: args{
+				message: "task: summary",
+				commit:  config.Commit{Style: config.StyleConventional, Types: []string{"task"}},
+			},
+		},
+		{
+			name: "summary_capital_conventional_unknown_type",
+			args: args{
+				message: "wip: summary",
+				commit:  config.Commit{Style: config.StyleConventional},
+			},
+			want: []lint.Result{
+				{
+					Rule:     "summary-capital",
+					Severity: config.SeverityWarning,
+					Problem:  lint.Problem{Line: 1, Column: 1, Message: "Summary should start with a capital letter."},
+				},
+			},
+		},
+		{
+			name: "summary_capital_emoji_style",
+			args: args{
+				message: ":art: update: Foo",
+				commit:  config.Commit{Style: config.StyleEmoji},
+			},
+			want: []lint.Result{
+				{
+					Rule:     "summary-capital",
+					Severity: config.SeverityWarning,
+					Problem:  lint.Problem{Line: 1, Column: 7, Message: "Summary should start with a capital letter."},
+				},
+			},
+		},
+		{
+			name: "blank_line",
+			args: args{
+				message: "Summary\nBody",
+			},
+			want: []lint.Result{
+				{
+					Rule:     "blank-line",
+					Severity: config.SeverityError,
+					Problem:  lint.Problem{Line: 2, Column: 1, Message: "Summary should be followed by a blank line."},
+				},
+			},
+		},
+		{
+			name: "body_width",
+			args: args{
+				message: "Summary\n\nThis body line is much longer than the seventy two characters that are allowed.\nhttps://example.com/a/very/long/url/that/cannot/be/wrapped/so/it/is/not/reported\n# This comment line is much longer than the seventy two characters allowed.",
+			},
+			want: []lint.Result{
+				{
+					Rule:     "body-width",
+					Severity: config.SeverityWarning,
+					Problem:  lint.Problem{Line: 3, Column: 73, Message: "Body line is 79 characters, limit is 72."},
+				},
+			},
+		},
+		{
+			name: "forbidden_words",
+			args: args{
+				message: "Summary WIP\n\nBody\n# wip",
+				config:  config.Lint{Words: []string{"wip", "fixup"}},
+			},
+			want: []lint.Result{
+				{
+					Rule:     "forbidden-words",
+					Severity: config.SeverityError,
+					Problem:  lint.Problem{Line: 1, Column: 9, Message: "Message contains forbidden word \"wip\"."},
+				},
+			},
+		},
+		{
+			name: "forbidden_words_partial",
+			args: args{
+				message: "Summary wiping",
+				config:  config.Lint{Words: []string{"wip"}},
+			},
+		},
+		{
+			name: "require_emoji",
+			args: args{
+				message: "Summary",
+				config:  config.Lint{RequireEmoji: config.SeverityError},
+			},
+			want: []lint.Result{
+				{
+					Rule:     "require-emoji",
+					Severity: config.SeverityError,
+					Problem:  lint.Problem{Line: 1, Column: 1, Message: "Summary should start with an emoji."},
+				},
+			},
+		},
+		{
+			name: "require_emoji_shortcode",
+			args: args{
+				message: ":art: Summary",
+				config:  config.Lint{RequireEmoji: config.SeverityError},
+			},
+		},
+		{
+			name: "require_emoji_conventional",
+			args: args{
+				message: "feat: :art: summary",
+				config:  config.Lint{RequireEmoji: config.SeverityError},
+				commit:  config.Commit{Style: config.StyleConventional},
+			},
+		},
+		{
+			name: "severity_off",
+			args: args{
+				message: "summary.",
+				config: config.Lint{
+					SummaryPeriod:  config.SeverityOff,
+					SummaryCapital: config.SeverityOff,
+				},
+			},
+		},
+		{
+			name: "severity_order",
+			args: args{
+				message: "summary\nbody",
+				config: config.Lint{
+					SummaryCapital: config.SeverityError,
+					BlankLine:      config.SeverityWarning,
+				},
+			},
+			want: []lint.Result{
+				{
+					Rule:     "summary-capital",
+					Severity: config.SeverityError,
+					Problem:  lint.Problem{Line: 1, Column: 1, Message: "Summary should start with a capital letter."},
+				},
+				{
+					Rule:     "blank-line",
+					Severity: config.SeverityWarning,
+					Problem:  lint.Problem{Line: 2, Column: 1, Message: "Summary should be followed by a blank line."},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := lint.New(config.Config{Lint: tt.args.config, Commit: tt.args.commit}).Lint(tt.args.message)
+			if len(tt.want) == 0 {
+				assert.Empty(t, got)
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestHasErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		results []lint.Result
+		want    bool
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name:    "warning",
+			results: []lint.Result{{Severity: config.SeverityWarning}},
+		},
+		{
+			name:    "error",
+			results: []lint.Result{{Severity: config.SeverityWarning}, {Severity: config.SeverityError}},
+			want:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, lint.HasErrors(tt.results))
+		})
+	}
+}
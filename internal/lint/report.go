@@ -0,0 +1,192 @@
+package lint
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/mikelorant/committed/internal/config"
+)
+
+type Format int
+
+const (
+	FormatUnset Format = iota
+	FormatText
+	FormatJSON
+	FormatGitHub
+)
+
+type jsonReport struct {
+	Name     string        `json:"name"`
+	Subject  string        `json:"subject"`
+	Problems []jsonProblem `json:"problems"`
+}
+
+type jsonProblem struct {
+	Rule     string `json:"rule"`
+	Severity string `json:"severity"`
+	Line     int    `json:"line"`
+	Column   int    `json:"column"`
+	Message  string `json:"message"`
+}
+
+var ErrFormat = errors.New("invalid output format")
+
+func (f Format) String() string {
+	return [...]string{
+		"",
+		"text",
+		"json",
+		"github",
+	}[f]
+}
+
+func ParseFormat(str string) (Format, error) {
+	f, ok := map[string]Format{
+		"":       FormatText,
+		"text":   FormatText,
+		"json":   FormatJSON,
+		"github": FormatGitHub,
+	}[strings.ToLower(str)]
+	if !ok {
+		return FormatUnset, fmt.Errorf("%w: %v", ErrFormat, str)
+	}
+
+	return f, nil
+}
+
+// Write prints the reports in the requested format.
+func Write(w io.Writer, rs []Report, f Format) error {
+	switch f {
+	case FormatJSON:
+		return writeJSON(w, rs)
+	case FormatGitHub:
+		writeGitHub(w, rs)
+	default:
+		writeText(w, rs)
+	}
+
+	return nil
+}
+
+// Failed reports whether any message has an error.
+func Failed(rs []Report) bool {
+	for _, r := range rs {
+		if HasErrors(r.Results) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func writeText(w io.Writer, rs []Report) {
+	var errs, warns int
+
+	for _, r := range rs {
+		errs += Count(r.Results, config.SeverityError)
+		warns += Count(r.Results, config.SeverityWarning)
+
+		if len(r.Results) == 0 {
+			continue
+		}
+
+		fmt.Fprintf(w, "%s %s\n", r.Name, r.Subject)
+
+		for _, res := range r.Results {
+			fmt.Fprintf(w, "  %d:%d  %-7s  %s  %s\n", res.Line, res.Column, res.Severity, res.Message, res.Rule)
+		}
+
+		fmt.Fprintln(w)
+	}
+
+	fmt.Fprintf(w, "%s, %s in %s.\n",
+		plural(errs, "error"),
+		plural(warns, "warning"),
+		plural(len(rs), "message"),
+	)
+}
+
+func writeJSON(w io.Writer, rs []Report) error {
+	jrs := make([]jsonReport, len(rs))
+
+	for i, r := range rs {
+		ps := make([]jsonProblem, len(r.Results))
+
+		for j, res := range r.Results {
+			ps[j] = jsonProblem{
+				Rule:     res.Rule,
+				Severity: res.Severity.String(),
+				Line:     res.Line,
+				Column:   res.Column,
+				Message:  res.Message,
+			}
+		}
+
+		jrs[i] = jsonReport{
+			Name:     r.Name,
+			Subject:  r.Subject,
+			Problems: ps,
+		}
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+
+	if err := enc.Encode(jrs); err != nil {
+		return fmt.Errorf("unable to encode reports: %w", err)
+	}
+
+	return nil
+}
+
+// writeGitHub prints workflow commands that GitHub Actions shows as
+// annotations. Messages from files are annotated against the file while
+// commits are identified in the title.
+func writeGitHub(w io.Writer, rs []Report) {
+	for _, r := range rs {
+		for _, res := range r.Results {
+			cmd := "warning"
+			if res.Severity == config.SeverityError {
+				cmd = "error"
+			}
+
+			props := fmt.Sprintf("title=%s (%s)", escapeProperty(res.Rule), escapeProperty(r.Name))
+			if r.File {
+				props = fmt.Sprintf("file=%s,line=%d,col=%d,title=%s",
+					escapeProperty(r.Name), res.Line, res.Column, escapeProperty(res.Rule))
+			}
+
+			fmt.Fprintf(w, "::%s %s::%s\n", cmd, props, escapeData(res.Message))
+		}
+	}
+}
+
+func escapeData(str string) string {
+	return strings.NewReplacer(
+		"%", "%25",
+		"\r", "%0D",
+		"\n", "%0A",
+	).Replace(str)
+}
+
+func escapeProperty(str string) string {
+	return strings.NewReplacer(
+		"%", "%25",
+		"\r", "%0D",
+		"\n", "%0A",
+		":", "%3A",
+		",", "%2C",
+	).Replace(str)
+}
+
+func plural(n int, word string) string {
+	if n == 1 {
+		return fmt.Sprintf("%d %s", n, word)
+	}
+
+	return fmt.Sprintf("%d %ss", n, word)
+}
@@ -0,0 +1,140 @@
+package lint_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/mikelorant/committed/internal/config"
+	"github.com/mikelorant/committed/internal/lint"
+
+	"github.com/hexops/autogold/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func mockReports() []lint.Report {
+	return []lint.Report{
+		{
+			Name:    "0a1b2c3",
+			Subject: "summary.",
+			Results: []lint.Result{
+				{
+					Rule:     "blank-line",
+					Severity: config.SeverityError,
+					Problem:  lint.Problem{Line: 2, Column: 1, Message: "Summary should be followed by a blank line."},
+				},
+				{
+					Rule:     "summary-period",
+					Severity: config.SeverityWarning,
+					Problem:  lint.Problem{Line: 1, Column: 8, Message: "Summary should not end with a period."},
+				},
+			},
+		},
+		{
+			Name:    "4d5e6f7",
+			Subject: "Summary",
+		},
+		{
+			Name:    ".git/COMMIT_EDITMSG",
+			Subject: "Summary, with 100% coverage",
+			File:    true,
+			Results: []lint.Result{
+				{
+					Rule:     "summary-length",
+					Severity: config.SeverityWarning,
+					Problem:  lint.Problem{Line: 1, Column: 51, Message: "Summary is 60 characters, limit is 50."},
+				},
+			},
+		},
+	}
+}
+
+func TestWrite(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		format  lint.Format
+		reports []lint.Report
+	}{
+		{
+			name:    "text",
+			format:  lint.FormatText,
+			reports: mockReports(),
+		},
+		{
+			name:    "json",
+			format:  lint.FormatJSON,
+			reports: mockReports(),
+		},
+		{
+			name:    "github",
+			format:  lint.FormatGitHub,
+			reports: mockReports(),
+		},
+		{
+			name:   "text_empty",
+			format: lint.FormatText,
+		},
+		{
+			name:   "json_empty",
+			format: lint.FormatJSON,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var buf bytes.Buffer
+
+			err := lint.Write(&buf, tt.reports, tt.format)
+			assert.Nil(t, err)
+
+			autogold.ExpectFile(t, autogold.Raw(buf.String()), autogold.Name(tt.name))
+		})
+	}
+}
+
+func TestParseFormat(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		format string
+		want   lint.Format
+		err    string
+	}{
+		{name: "empty", want: lint.FormatText},
+		{name: "text", format: "text", want: lint.FormatText},
+		{name: "json", format: "JSON", want: lint.FormatJSON},
+		{name: "github", format: "github", want: lint.FormatGitHub},
+		{name: "invalid", format: "xml", err: "invalid output format: xml"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			f, err := lint.ParseFormat(tt.format)
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+				return
+			}
+			assert.Nil(t, err)
+
+			assert.Equal(t, tt.want, f)
+		})
+	}
+}
+
+func TestFailed(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, lint.Failed(mockReports()))
+	assert.False(t, lint.Failed(mockReports()[1:]))
+	assert.False(t, lint.Failed(nil))
+}
@@ -0,0 +1,221 @@
+package lint
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/mikelorant/committed/internal/commit"
+	"github.com/mikelorant/committed/internal/config"
+	"github.com/mikelorant/committed/internal/emoji"
+)
+
+const (
+	defaultSummaryLimit = 50
+	defaultBodyLimit    = 72
+)
+
+// Rules returns the built-in rules in the order they are reported.
+func Rules() []Rule {
+	return []Rule{
+		{
+			Name:     "summary-length",
+			Default:  config.SeverityWarning,
+			Severity: func(c config.Lint) config.Severity { return c.SummaryLength },
+			Check:    summaryLength,
+		},
+		{
+			Name:     "summary-period",
+			Default:  config.SeverityWarning,
+			Severity: func(c config.Lint) config.Severity { return c.SummaryPeriod },
+			Check:    summaryPeriod,
+		},
+		{
+			Name:     "summary-capital",
+			Default:  config.SeverityWarning,
+			Severity: func(c config.Lint) config.Severity { return c.SummaryCapital },
+			Check:    summaryCapital,
+		},
+		{
+			Name:     "blank-line",
+			Default:  config.SeverityError,
+			Severity: func(c config.Lint) config.Severity { return c.BlankLine },
+			Check:    blankLine,
+		},
+		{
+			Name:     "body-width",
+			Default:  config.SeverityWarning,
+			Severity: func(c config.Lint) config.Severity { return c.BodyWidth },
+			Check:    bodyWidth,
+		},
+		{
+			Name:     "forbidden-words",
+			Default:  config.SeverityError,
+			Severity: func(c config.Lint) config.Severity { return c.ForbiddenWords },
+			Check:    forbiddenWords,
+		},
+		{
+			Name:     "require-emoji",
+			Default:  config.SeverityOff,
+			Severity: func(c config.Lint) config.Severity { return c.RequireEmoji },
+			Check:    requireEmoji,
+		},
+	}
+}
+
+func summaryLength(m Message, l Linter) []Problem {
+	limit := l.config.SummaryLimit
+	if limit == 0 {
+		limit = defaultSummaryLimit
+	}
+
+	summary := commit.TrimEmoji(m.Subject)
+
+	n := utf8.RuneCountInString(summary)
+	if n <= limit {
+		return nil
+	}
+
+	return []Problem{{
+		Line:    1,
+		Column:  utf8.RuneCountInString(m.Subject) - n + limit + 1,
+		Message: fmt.Sprintf("Summary is %d characters, limit is %d.", n, limit),
+	}}
+}
+
+func summaryPeriod(m Message, _ Linter) []Problem {
+	if !strings.HasSuffix(m.Subject, ".") {
+		return nil
+	}
+
+	return []Problem{{
+		Line:    1,
+		Column:  utf8.RuneCountInString(m.Subject),
+		Message: "Summary should not end with a period.",
+	}}
+}
+
+// summaryCapital is skipped for conventional commits of a configured type,
+// which start the description in lowercase.
+func summaryCapital(m Message, l Linter) []Problem {
+	if l.conventional(m.Subject) {
+		return nil
+	}
+
+	summary := commit.TrimEmoji(m.Subject)
+
+	r, _ := utf8.DecodeRuneInString(summary)
+	if r == utf8.RuneError || !unicode.IsLower(r) {
+		return nil
+	}
+
+	return []Problem{{
+		Line:    1,
+		Column:  utf8.RuneCountInString(m.Subject) - utf8.RuneCountInString(summary) + 1,
+		Message: "Summary should start with a capital letter.",
+	}}
+}
+
+func blankLine(m Message, _ Linter) []Problem {
+	if len(m.Lines) < 2 || m.Lines[1] == "" {
+		return nil
+	}
+
+	return []Problem{{
+		Line:    2,
+		Column:  1,
+		Message: "Summary should be followed by a blank line.",
+	}}
+}
+
+func bodyWidth(m Message, l Linter) []Problem {
+	var ps []Problem
+
+	limit := l.config.BodyLimit
+	if limit == 0 {
+		limit = defaultBodyLimit
+	}
+
+	for i, l := range m.Lines {
+		if i < 2 || isComment(l) || !strings.ContainsAny(l, " \t") {
+			continue
+		}
+
+		if utf8.RuneCountInString(l) <= limit {
+			continue
+		}
+
+		ps = append(ps, Problem{
+			Line:    i + 1,
+			Column:  limit + 1,
+			Message: fmt.Sprintf("Body line is %d characters, limit is %d.", utf8.RuneCountInString(l), limit),
+		})
+	}
+
+	return ps
+}
+
+func forbiddenWords(m Message, l Linter) []Problem {
+	var ps []Problem
+
+	for _, w := range l.words {
+		for i, line := range m.Lines {
+			if isComment(line) {
+				continue
+			}
+
+			loc := w.re.FindStringIndex(line)
+			if loc == nil {
+				continue
+			}
+
+			ps = append(ps, Problem{
+				Line:    i + 1,
+				Column:  utf8.RuneCountInString(line[:loc[0]]) + 1,
+				Message: fmt.Sprintf("Message contains forbidden word %q.", w.text),
+			})
+		}
+	}
+
+	return ps
+}
+
+// compileWords returns a pattern matching each forbidden word regardless of
+// case.
+func compileWords(words []string) []word {
+	ws := make([]word, 0, len(words))
+
+	for _, w := range words {
+		ws = append(ws, word{
+			text: w,
+			re:   regexp.MustCompile(`(?i)\b` + regexp.QuoteMeta(w) + `\b`),
+		})
+	}
+
+	return ws
+}
+
+// requireEmoji accepts the emoji after the prefix of a conventional commit.
+func requireEmoji(m Message, l Linter) []Problem {
+	subject := m.Subject
+	if l.conventional(subject) {
+		subject = commit.TrimConventional(subject, l.types)
+	}
+
+	fw := strings.Split(subject, " ")[0]
+	if emoji.Has(fw) {
+		return nil
+	}
+
+	return []Problem{{
+		Line:    1,
+		Column:  1,
+		Message: "Summary should start with an emoji.",
+	}}
+}
+
+func isComment(line string) bool {
+	return strings.HasPrefix(line, "#")
+}
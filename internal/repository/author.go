@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/storer"
+)
+
+type Logger interface {
+	Log(o *git.LogOptions) (object.CommitIter, error)
+}
+
+// Number of commits searched for distinct authors.
+const historyLimit = 1000
+
+func (r *Repository) Authors() ([]User, error) {
+	iter, err := r.Logger.Log(&git.LogOptions{})
+
+	switch {
+	case err == nil:
+	case err.Error() == plumbing.ErrReferenceNotFound.Error():
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("unable to get commit log: %w", err)
+	}
+
+	defer iter.Close()
+
+	var users []User
+
+	var count int
+
+	seen := make(map[string]bool)
+
+	err = iter.ForEach(func(c *object.Commit) error {
+		if count >= historyLimit {
+			return storer.ErrStop
+		}
+		count++
+
+		key := strings.ToLower(c.Author.Email)
+		if c.Author.Email == "" || seen[key] {
+			return nil
+		}
+		seen[key] = true
+
+		users = append(users, User{
+			Name:  c.Author.Name,
+			Email: c.Author.Email,
+		})
+
+		return nil
+	})
+	if err != nil {
+		return users, fmt.Errorf("unable to read commit log: %w", err)
+	}
+
+	return users, nil
+}
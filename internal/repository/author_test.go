@@ -0,0 +1,165 @@
+package repository_test
+
+import (
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/mikelorant/committed/internal/repository"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/storer"
+	"github.com/stretchr/testify/assert"
+)
+
+type MockRepositoryLog struct {
+	authors []repository.User
+	err     error
+}
+
+type MockCommitIter struct {
+	commits []*object.Commit
+	pos     int
+}
+
+var errMockLog = errors.New("error")
+
+func (m MockRepositoryLog) Log(o *git.LogOptions) (object.CommitIter, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+
+	var cs []*object.Commit
+
+	for _, a := range m.authors {
+		cs = append(cs, &object.Commit{
+			Author: object.Signature{
+				Name:  a.Name,
+				Email: a.Email,
+			},
+		})
+	}
+
+	return &MockCommitIter{commits: cs}, nil
+}
+
+func (m *MockCommitIter) Next() (*object.Commit, error) {
+	if m.pos >= len(m.commits) {
+		return nil, io.EOF
+	}
+
+	c := m.commits[m.pos]
+	m.pos++
+
+	return c, nil
+}
+
+func (m *MockCommitIter) ForEach(fn func(*object.Commit) error) error {
+	for {
+		c, err := m.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		err = fn(c)
+		switch {
+		case err == nil:
+		case errors.Is(err, storer.ErrStop):
+			return nil
+		default:
+			return err
+		}
+	}
+}
+
+func (m *MockCommitIter) Close() {}
+
+func TestAuthors(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		authors []repository.User
+		err     error
+	}
+
+	type want struct {
+		authors []repository.User
+		err     string
+	}
+
+	tests := []struct {
+		name string
+		args args
+		want want
+	}{
+		{
+			name: "distinct",
+			args: args{
+				authors: []repository.User{
+					{Name: "John Doe", Email: "john.doe@example.com"},
+					{Name: "Jane Doe", Email: "jane.doe@example.com"},
+					{Name: "John Doe", Email: "john.doe@example.com"},
+					{Name: "John", Email: "John.Doe@example.com"},
+				},
+			},
+			want: want{
+				authors: []repository.User{
+					{Name: "John Doe", Email: "john.doe@example.com"},
+					{Name: "Jane Doe", Email: "jane.doe@example.com"},
+				},
+			},
+		},
+		{
+			name: "no_email",
+			args: args{
+				authors: []repository.User{
+					{Name: "John Doe"},
+				},
+			},
+		},
+		{
+			name: "empty",
+		},
+		{
+			name: "no_head",
+			args: args{
+				err: plumbing.ErrReferenceNotFound,
+			},
+		},
+		{
+			name: "error",
+			args: args{
+				err: errMockLog,
+			},
+			want: want{
+				err: "unable to get commit log: error",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			r := repository.Repository{
+				Logger: MockRepositoryLog{
+					authors: tt.args.authors,
+					err:     tt.args.err,
+				},
+			}
+
+			as, err := r.Authors()
+			if tt.want.err != "" {
+				assert.EqualError(t, err, tt.want.err)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, tt.want.authors, as)
+		})
+	}
+}
@@ -10,7 +10,7 @@ type Commit struct {
 	Author      string
 	Subject     string
 	Body        string
-	Footer      string
+	Trailers    []Trailer
 	Amend       bool
 	DryRun      bool
 	File        bool
@@ -59,8 +59,8 @@ func build(c Commit) []string {
 		args = append(args, "--message", c.Body)
 	}
 
-	if c.Footer != "" {
-		args = append(args, "--message", c.Footer)
+	if len(c.Trailers) > 0 {
+		args = append(args, "--message", TrailersToString(c.Trailers))
 	}
 
 	if c.DryRun {
@@ -87,8 +87,8 @@ func write(c Commit, w io.WriteCloser) error {
 		fmt.Fprintln(w, "")
 	}
 
-	if c.Footer != "" {
-		fmt.Fprintln(w, c.Footer)
+	if len(c.Trailers) > 0 {
+		fmt.Fprintln(w, TrailersToString(c.Trailers))
 	}
 
 	if err = w.Close(); err != nil {
@@ -89,10 +89,10 @@ func TestApply(t *testing.T) {
 			name: "full",
 			args: args{
 				commit: repository.Commit{
-					Author:  "John Doe <john.doe@example.com",
-					Subject: ":art: summary",
-					Body:    "body",
-					Footer:  "Signed-off-by: John Doe <john.doe@example.com",
+					Author:   "John Doe <john.doe@example.com",
+					Subject:  ":art: summary",
+					Body:     "body",
+					Trailers: []repository.Trailer{{Key: "Signed-off-by", Value: "John Doe <john.doe@example.com"}},
 				},
 			},
 			want: want{
@@ -107,12 +107,34 @@ func TestApply(t *testing.T) {
 			},
 		},
 		{
-			name: "no_body",
+			name: "trailers",
 			args: args{
 				commit: repository.Commit{
-					Author:  "John Doe <john.doe@example.com",
+					Author:  "John Doe <john.doe@example.com>",
 					Subject: ":art: summary",
-					Footer:  "Signed-off-by: John Doe <john.doe@example.com",
+					Trailers: []repository.Trailer{
+						{Key: "Co-authored-by", Value: "Jane Doe <jane.doe@example.com>"},
+						{Key: "Fixes", Value: "#123"},
+					},
+				},
+			},
+			want: want{
+				cmd: "git",
+				args: []string{
+					"commit",
+					"--author", "John Doe <john.doe@example.com>",
+					"--message", ":art: summary",
+					"--message", "Co-authored-by: Jane Doe <jane.doe@example.com>\nFixes: #123",
+				},
+			},
+		},
+		{
+			name: "no_body",
+			args: args{
+				commit: repository.Commit{
+					Author:   "John Doe <john.doe@example.com",
+					Subject:  ":art: summary",
+					Trailers: []repository.Trailer{{Key: "Signed-off-by", Value: "John Doe <john.doe@example.com"}},
 				},
 			},
 			want: want{
@@ -225,7 +247,7 @@ func TestApply(t *testing.T) {
 			args: args{
 				commit: repository.Commit{
 					Subject:     "summary",
-					Footer:      "Signed-off-by: John Doe <john.doe@example.com>",
+					Trailers:    []repository.Trailer{{Key: "Signed-off-by", Value: "John Doe <john.doe@example.com>"}},
 					MessageFile: "test",
 				},
 			},
@@ -240,7 +262,7 @@ func TestApply(t *testing.T) {
 				commit: repository.Commit{
 					Subject:     "summary",
 					Body:        "body",
-					Footer:      "Signed-off-by: John Doe <john.doe@example.com>",
+					Trailers:    []repository.Trailer{{Key: "Signed-off-by", Value: "John Doe <john.doe@example.com>"}},
 					MessageFile: "test",
 				},
 			},
@@ -47,12 +47,15 @@ type Repository struct {
 	Configer     Configer
 	Remoter      Remoter
 	Header       Header
+	Logger       Logger
+	Resolver     Resolver
 	Brancher     Brancher
 	Worktreer    Worktreer
 }
 
 type Description struct {
 	Users    []User
+	Authors  []User
 	Remotes  []string
 	Head     Head
 	Branch   Branch
@@ -87,6 +90,8 @@ func (r *Repository) Open() error {
 	r.Configer = repo
 	r.Remoter = repo
 	r.Header = repo
+	r.Logger = repo
+	r.Resolver = repo
 	r.Brancher = repo
 	r.Worktreer = repo
 
@@ -99,6 +104,11 @@ func (r *Repository) Describe() (Description, error) {
 		return Description{}, fmt.Errorf("unable to get users: %w", err)
 	}
 
+	as, err := r.Authors()
+	if err != nil {
+		return Description{}, fmt.Errorf("unable to get authors: %w", err)
+	}
+
 	rs, err := r.Remotes()
 	if err != nil {
 		return Description{}, fmt.Errorf("unable to get remotes: %w", err)
@@ -121,6 +131,7 @@ func (r *Repository) Describe() (Description, error) {
 
 	return Description{
 		Users:    us,
+		Authors:  as,
 		Remotes:  rs,
 		Head:     h,
 		Branch:   b,
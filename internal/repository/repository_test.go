@@ -85,6 +85,7 @@ func TestDescribe(t *testing.T) {
 	type args struct {
 		localBranch string
 		userErr     error
+		authorErr   error
 		remoteErr   error
 		headErr     error
 		branchErr   error
@@ -128,6 +129,15 @@ func TestDescribe(t *testing.T) {
 				err: errMockDescribe,
 			},
 		},
+		{
+			name: "error_author",
+			args: args{
+				authorErr: errMockDescribe,
+			},
+			want: want{
+				err: errMockDescribe,
+			},
+		},
 		{
 			name: "error_remote",
 			args: args{
@@ -178,6 +188,7 @@ func TestDescribe(t *testing.T) {
 				GlobalConfig: MockGlobalConfig("", "", nil),
 				Remoter:      MockRepositoryRemote{err: tt.args.remoteErr},
 				Header:       MockRepositoryHead{headErr: tt.args.headErr},
+				Logger:       MockRepositoryLog{err: tt.args.authorErr},
 				Brancher:     &MockRepositoryBranch{local: tt.args.localBranch, headErr: tt.args.branchErr},
 				Worktreer:    MockRepositoryWorktree{fixture: fixtures.Basic().One(), err: tt.args.worktreeErr},
 			}
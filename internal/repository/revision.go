@@ -0,0 +1,192 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+// side marks the ends of a revision range a commit is reachable from.
+type side int
+
+const (
+	sideTo side = 1 << iota
+	sideFrom
+)
+
+type Resolver interface {
+	ResolveRevision(plumbing.Revision) (*plumbing.Hash, error)
+}
+
+// Revision is a commit selected by a revision range.
+type Revision struct {
+	Hash    string
+	Message string
+	Merge   bool
+}
+
+const (
+	rangeSeparator  = ".."
+	defaultRevision = "HEAD"
+)
+
+var ErrRevisionRange = errors.New("invalid revision range")
+
+// Revisions returns the commits in a revision range such as "main..HEAD",
+// newest first. A single revision returns only that commit.
+func (r *Repository) Revisions(rng string) ([]Revision, error) {
+	if strings.Contains(rng, rangeSeparator+".") {
+		return nil, fmt.Errorf("%w: %v", ErrRevisionRange, rng)
+	}
+
+	from, to, found := strings.Cut(rng, rangeSeparator)
+	if !found {
+		return r.revision(rng)
+	}
+
+	if from == "" {
+		from = defaultRevision
+	}
+
+	if to == "" {
+		to = defaultRevision
+	}
+
+	fromHash, err := r.resolve(from)
+	if err != nil {
+		return nil, err
+	}
+
+	toHash, err := r.resolve(to)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.between(fromHash, toHash)
+}
+
+// between returns the commits reachable from one commit but not from
+// another, newest first. Both histories are walked together by commit date
+// and painted with the side they are reachable from. The walk stops once only
+// commits reachable from the excluded side remain, so the history below the
+// merge base is never read.
+func (r *Repository) between(from, to plumbing.Hash) ([]Revision, error) {
+	var (
+		queue []*object.Commit
+		order []*object.Commit
+	)
+
+	paint := make(map[plumbing.Hash]side)
+	queued := make(map[plumbing.Hash]bool)
+	walked := make(map[plumbing.Hash]bool)
+
+	push := func(h plumbing.Hash, s side) error {
+		if paint[h]|s == paint[h] {
+			return nil
+		}
+
+		paint[h] |= s
+
+		if queued[h] {
+			return nil
+		}
+
+		c, err := r.Header.CommitObject(h)
+		if err != nil {
+			return fmt.Errorf("unable to get commit: %v: %w", h, err)
+		}
+
+		i := sort.Search(len(queue), func(i int) bool {
+			return queue[i].Committer.When.Before(c.Committer.When)
+		})
+
+		queue = append(queue, nil)
+		copy(queue[i+1:], queue[i:])
+		queue[i] = c
+		queued[h] = true
+
+		return nil
+	}
+
+	if err := push(to, sideTo); err != nil {
+		return nil, err
+	}
+
+	if err := push(from, sideFrom); err != nil {
+		return nil, err
+	}
+
+	for len(queue) > 0 && !excludedOnly(queue, paint) {
+		c := queue[0]
+		queue = queue[1:]
+		queued[c.Hash] = false
+
+		if !walked[c.Hash] {
+			walked[c.Hash] = true
+			order = append(order, c)
+		}
+
+		for _, p := range c.ParentHashes {
+			if err := push(p, paint[c.Hash]); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	var revs []Revision
+
+	for _, c := range order {
+		if paint[c.Hash] == sideTo {
+			revs = append(revs, toRevision(c))
+		}
+	}
+
+	return revs, nil
+}
+
+func (r *Repository) revision(rev string) ([]Revision, error) {
+	h, err := r.resolve(rev)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := r.Header.CommitObject(h)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get commit: %v: %w", h, err)
+	}
+
+	return []Revision{toRevision(c)}, nil
+}
+
+func (r *Repository) resolve(rev string) (plumbing.Hash, error) {
+	h, err := r.Resolver.ResolveRevision(plumbing.Revision(rev))
+	if err != nil {
+		return plumbing.ZeroHash, fmt.Errorf("unable to resolve revision: %v: %w", rev, err)
+	}
+
+	return *h, nil
+}
+
+// excludedOnly reports if every queued commit is reachable from the excluded
+// side of a range.
+func excludedOnly(queue []*object.Commit, paint map[plumbing.Hash]side) bool {
+	for _, c := range queue {
+		if paint[c.Hash]&sideFrom == 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+func toRevision(c *object.Commit) Revision {
+	return Revision{
+		Hash:    c.Hash.String(),
+		Message: c.Message,
+		Merge:   c.NumParents() > 1,
+	}
+}
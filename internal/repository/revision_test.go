@@ -0,0 +1,253 @@
+package repository_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/mikelorant/committed/internal/repository"
+
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/stretchr/testify/assert"
+)
+
+// MockRepositoryRevision is a linear history with the newest commit last.
+type MockRepositoryRevision struct {
+	history    []*object.Commit
+	refs       map[string]int
+	resolveErr error
+	commitErr  error
+}
+
+var errMockRevision = errors.New("error")
+
+func (m MockRepositoryRevision) ResolveRevision(rev plumbing.Revision) (*plumbing.Hash, error) {
+	if m.resolveErr != nil {
+		return nil, m.resolveErr
+	}
+
+	i, ok := m.refs[string(rev)]
+	if !ok {
+		return nil, plumbing.ErrReferenceNotFound
+	}
+
+	return &m.history[i].Hash, nil
+}
+
+func (m MockRepositoryRevision) Head() (*plumbing.Reference, error) {
+	return nil, nil
+}
+
+func (m MockRepositoryRevision) CommitObject(h plumbing.Hash) (*object.Commit, error) {
+	if m.commitErr != nil {
+		return nil, m.commitErr
+	}
+
+	for _, c := range m.history {
+		if c.Hash == h {
+			return c, nil
+		}
+	}
+
+	return nil, plumbing.ErrObjectNotFound
+}
+
+func mockHistory(msgs ...string) []*object.Commit {
+	var cs []*object.Commit
+
+	for i, msg := range msgs {
+		c := &object.Commit{
+			Hash:      plumbing.NewHash(fmt.Sprintf("%040d", i+1)),
+			Message:   msg,
+			Committer: object.Signature{When: time.Date(2022, time.January, 1, i, 0, 0, 0, time.UTC)},
+		}
+
+		if i > 0 {
+			c.ParentHashes = []plumbing.Hash{cs[i-1].Hash}
+		}
+
+		cs = append(cs, c)
+	}
+
+	return cs
+}
+
+func TestRevisions(t *testing.T) {
+	t.Parallel()
+
+	history := mockHistory("first", "second", "third", "fourth")
+	history[3].ParentHashes = append(history[3].ParentHashes, history[0].Hash)
+
+	refs := map[string]int{
+		"main": 1,
+		"HEAD": 3,
+	}
+
+	type args struct {
+		rng        string
+		resolveErr error
+		commitErr  error
+	}
+
+	type want struct {
+		messages []string
+		merges   []bool
+		err      string
+	}
+
+	tests := []struct {
+		name string
+		args args
+		want want
+	}{
+		{
+			name: "range",
+			args: args{
+				rng: "main..HEAD",
+			},
+			want: want{
+				messages: []string{"fourth", "third"},
+				merges:   []bool{true, false},
+			},
+		},
+		{
+			name: "range_default_to",
+			args: args{
+				rng: "main..",
+			},
+			want: want{
+				messages: []string{"fourth", "third"},
+				merges:   []bool{true, false},
+			},
+		},
+		{
+			name: "range_default_from",
+			args: args{
+				rng: "..main",
+			},
+		},
+		{
+			name: "single",
+			args: args{
+				rng: "main",
+			},
+			want: want{
+				messages: []string{"second"},
+				merges:   []bool{false},
+			},
+		},
+		{
+			name: "symmetric",
+			args: args{
+				rng: "main...HEAD",
+			},
+			want: want{
+				err: "invalid revision range: main...HEAD",
+			},
+		},
+		{
+			name: "unknown",
+			args: args{
+				rng: "main..invalid",
+			},
+			want: want{
+				err: "unable to resolve revision: invalid: reference not found",
+			},
+		},
+		{
+			name: "resolve_error",
+			args: args{
+				rng:        "main",
+				resolveErr: errMockRevision,
+			},
+			want: want{
+				err: "unable to resolve revision: main: error",
+			},
+		},
+		{
+			name: "commit_error",
+			args: args{
+				rng:       "main..HEAD",
+				commitErr: errMockRevision,
+			},
+			want: want{
+				err: "unable to get commit: 0000000000000000000000000000000000000004: error",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			m := MockRepositoryRevision{
+				history:    history,
+				refs:       refs,
+				resolveErr: tt.args.resolveErr,
+				commitErr:  tt.args.commitErr,
+			}
+
+			r := repository.Repository{
+				Header:   m,
+				Resolver: m,
+			}
+
+			revs, err := r.Revisions(tt.args.rng)
+			if tt.want.err != "" {
+				assert.EqualError(t, err, tt.want.err)
+				return
+			}
+			assert.Nil(t, err)
+
+			var (
+				msgs   []string
+				merges []bool
+			)
+
+			for _, rev := range revs {
+				assert.Len(t, rev.Hash, 40)
+				msgs = append(msgs, rev.Message)
+				merges = append(merges, rev.Merge)
+			}
+
+			assert.Equal(t, tt.want.messages, msgs)
+			assert.Equal(t, tt.want.merges, merges)
+		})
+	}
+}
+
+func TestRevisionsMergeBase(t *testing.T) {
+	t.Parallel()
+
+	// The parent of the oldest commit is missing, so reading the history
+	// below the merge base fails.
+	history := mockHistory("first", "second", "third", "fourth")
+	history[0].ParentHashes = []plumbing.Hash{plumbing.NewHash("ff")}
+
+	m := MockRepositoryRevision{
+		history: history,
+		refs: map[string]int{
+			"main": 1,
+			"HEAD": 3,
+		},
+	}
+
+	r := repository.Repository{
+		Header:   m,
+		Resolver: m,
+	}
+
+	revs, err := r.Revisions("main..HEAD")
+	assert.NoError(t, err)
+
+	var msgs []string
+	for _, rev := range revs {
+		msgs = append(msgs, rev.Message)
+	}
+
+	assert.Equal(t, []string{"fourth", "third"}, msgs)
+}
@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"fmt"
+	"strings"
+)
+
+type Trailer struct {
+	Key   string `yaml:"key"`
+	Value string `yaml:"value"`
+}
+
+func (t Trailer) String() string {
+	return fmt.Sprintf("%s: %s", t.Key, t.Value)
+}
+
+func TrailersToString(ts []Trailer) string {
+	lines := make([]string, len(ts))
+	for i, t := range ts {
+		lines[i] = t.String()
+	}
+
+	return strings.Join(lines, "\n")
+}
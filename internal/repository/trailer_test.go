@@ -0,0 +1,50 @@
+package repository_test
+
+import (
+	"testing"
+
+	"github.com/mikelorant/committed/internal/repository"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTrailersToString(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		trailers []repository.Trailer
+		want     string
+	}{
+		{
+			name: "empty",
+			want: "",
+		},
+		{
+			name: "single",
+			trailers: []repository.Trailer{
+				{Key: "Signed-off-by", Value: "John Doe <john.doe@example.com>"},
+			},
+			want: "Signed-off-by: John Doe <john.doe@example.com>",
+		},
+		{
+			name: "multiple",
+			trailers: []repository.Trailer{
+				{Key: "Co-authored-by", Value: "Jane Doe <jane.doe@example.com>"},
+				{Key: "Fixes", Value: "#123"},
+				{Key: "Change-Id", Value: "I1234567890abcdef"},
+			},
+			want: "Co-authored-by: Jane Doe <jane.doe@example.com>\nFixes: #123\nChange-Id: I1234567890abcdef",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, repository.TrailersToString(tt.trailers))
+		})
+	}
+}
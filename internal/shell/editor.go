@@ -0,0 +1,62 @@
+package shell
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+)
+
+const defaultEditor = "vi"
+
+// editorEnvs are checked in order to find the preferred editor.
+var editorEnvs = []string{"VISUAL", "EDITOR"}
+
+// messageEditorEnv is checked before the other editors for commit messages,
+// as it is by Git.
+const messageEditorEnv = "GIT_EDITOR"
+
+// Editor returns the command of the preferred editor.
+func Editor() string {
+	for _, env := range editorEnvs {
+		if e := os.Getenv(env); e != "" {
+			return e
+		}
+	}
+
+	return defaultEditor
+}
+
+// MessageEditor returns the command of the preferred editor for commit
+// messages.
+func MessageEditor() string {
+	if e := os.Getenv(messageEditorEnv); e != "" {
+		return e
+	}
+
+	return Editor()
+}
+
+// EditMessageCommand returns the command that opens a message file in the
+// preferred editor for commit messages. The caller attaches the terminal.
+func EditMessageCommand(file string) *exec.Cmd {
+	return editorCommand(MessageEditor(), file)
+}
+
+// Edit opens a file in the preferred editor attached to the terminal.
+func Edit(file string) error {
+	cmd := editorCommand(Editor(), file)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("unable to run editor: %w", err)
+	}
+
+	return nil
+}
+
+// editorCommand runs the editor by the shell so it may include arguments.
+func editorCommand(editor, file string) *exec.Cmd {
+	return exec.Command("sh", "-c", editor+` "$@"`, "sh", file)
+}
@@ -0,0 +1,101 @@
+package shell_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/mikelorant/committed/internal/shell"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEditor(t *testing.T) {
+	tests := []struct {
+		name   string
+		visual string
+		editor string
+		want   string
+	}{
+		{
+			name: "default",
+			want: "vi",
+		},
+		{
+			name:   "editor",
+			editor: "nano",
+			want:   "nano",
+		},
+		{
+			name:   "visual",
+			visual: "code --wait",
+			editor: "nano",
+			want:   "code --wait",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("VISUAL", tt.visual)
+			t.Setenv("EDITOR", tt.editor)
+
+			assert.Equal(t, tt.want, shell.Editor())
+		})
+	}
+}
+
+func TestMessageEditor(t *testing.T) {
+	tests := []struct {
+		name      string
+		gitEditor string
+		visual    string
+		editor    string
+		want      string
+	}{
+		{
+			name: "default",
+			want: "vi",
+		},
+		{
+			name:   "editor",
+			editor: "nano",
+			want:   "nano",
+		},
+		{
+			name:   "visual",
+			visual: "code --wait",
+			editor: "nano",
+			want:   "code --wait",
+		},
+		{
+			name:      "git_editor",
+			gitEditor: "vim -c 'set spell'",
+			visual:    "code --wait",
+			editor:    "nano",
+			want:      "vim -c 'set spell'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("GIT_EDITOR", tt.gitEditor)
+			t.Setenv("VISUAL", tt.visual)
+			t.Setenv("EDITOR", tt.editor)
+
+			assert.Equal(t, tt.want, shell.MessageEditor())
+		})
+	}
+}
+
+func TestEditMessageCommand(t *testing.T) {
+	t.Setenv("GIT_EDITOR", "sed -i s/draft/final/")
+
+	file := filepath.Join(t.TempDir(), "COMMIT_EDITMSG")
+	assert.NoError(t, os.WriteFile(file, []byte("draft body\n"), 0o600))
+
+	assert.NoError(t, shell.EditMessageCommand(file).Run())
+
+	got, err := os.ReadFile(file)
+	assert.NoError(t, err)
+	assert.Equal(t, "final body\n", string(got))
+}
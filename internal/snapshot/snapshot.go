@@ -11,13 +11,16 @@ import (
 )
 
 type Snapshot struct {
-	Emoji   string          `yaml:"emoji,omitempty"`
-	Summary string          `yaml:"summary,omitempty"`
-	Body    string          `yaml:"body,omitempty"`
-	Footer  string          `yaml:"footer,omitempty"`
-	Author  repository.User `yaml:"author,omitempty"`
-	Amend   bool            `yaml:"amend,omitempty"`
-	Restore bool            `yaml:"restore,omitempty"`
+	Emoji    string               `yaml:"emoji,omitempty"`
+	Summary  string               `yaml:"summary,omitempty"`
+	Type     string               `yaml:"type,omitempty"`
+	Scope    string               `yaml:"scope,omitempty"`
+	Breaking bool                 `yaml:"breaking,omitempty"`
+	Body     string               `yaml:"body,omitempty"`
+	Trailers []repository.Trailer `yaml:"trailers,omitempty"`
+	Author   repository.User      `yaml:"author,omitempty"`
+	Amend    bool                 `yaml:"amend,omitempty"`
+	Restore  bool                 `yaml:"restore,omitempty"`
 }
 
 var (
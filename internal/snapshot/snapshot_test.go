@@ -55,7 +55,9 @@ func TestLoad(t *testing.T) {
 					emoji: ":art:"
 					summary: summary
 					body: body
-					footer: footer
+					trailers:
+					  - key: Fixes
+					    value: "#1"
 					author:
 					  name: John Doe
 					  email: john.doe@example.com
@@ -64,10 +66,10 @@ func TestLoad(t *testing.T) {
 			},
 			want: want{
 				snapshot: snapshot.Snapshot{
-					Emoji:   ":art:",
-					Summary: "summary",
-					Body:    "body",
-					Footer:  "footer",
+					Emoji:    ":art:",
+					Summary:  "summary",
+					Body:     "body",
+					Trailers: []repository.Trailer{{Key: "Fixes", Value: "#1"}},
 					Author: repository.User{
 						Name:  "John Doe",
 						Email: "john.doe@example.com",
@@ -149,10 +151,10 @@ func TestSave(t *testing.T) {
 			args: args{
 				writer: new(readWriteCloser),
 				snapshot: snapshot.Snapshot{
-					Emoji:   ":art:",
-					Summary: "summary",
-					Body:    "body",
-					Footer:  "footer",
+					Emoji:    ":art:",
+					Summary:  "summary",
+					Body:     "body",
+					Trailers: []repository.Trailer{{Key: "Fixes", Value: "#1"}},
 					Author: repository.User{
 						Name:  "John Doe",
 						Email: "john.doe@example.com",
@@ -165,7 +167,9 @@ func TestSave(t *testing.T) {
 					emoji: ':art:'
 					summary: summary
 					body: body
-					footer: footer
+					trailers:
+					    - key: Fixes
+					      value: '#1'
 					author:
 					    name: John Doe
 					    email: john.doe@example.com
@@ -0,0 +1,215 @@
+package theme
+
+import (
+	"github.com/mikelorant/committed/internal/config"
+
+	"github.com/charmbracelet/lipgloss"
+	tint "github.com/lrstanley/bubbletint"
+	"github.com/muesli/gamut"
+)
+
+// custom is a theme defined in the config. Colours missing from the palette
+// are taken from the base tint.
+type custom struct {
+	name    string
+	palette config.Palette
+	base    tint.Tint
+}
+
+// accentBlends is the number of steps blended between a standard colour and
+// the accent. The first step, a quarter of the accent, is used.
+const accentBlends = 3
+
+// Standard ANSI colours that a generated palette blends with the accent.
+var ansi = config.Palette{
+	Red:          "#cc0000",
+	Green:        "#4e9a06",
+	Yellow:       "#c4a000",
+	Blue:         "#3465a4",
+	Purple:       "#75507b",
+	BrightRed:    "#ef2929",
+	BrightGreen:  "#8ae234",
+	BrightYellow: "#fce94f",
+	BrightBlue:   "#729fcf",
+	BrightPurple: "#ad7fa8",
+}
+
+// newCustom returns the tint of a custom theme for a dark or light
+// background. Colours that are neither set nor generated are taken from the
+// base tint.
+func newCustom(ct config.CustomTheme, dark bool, base tint.Tint) tint.Tint {
+	if ct.Accent != "" {
+		base = custom{
+			name:    ct.Name,
+			palette: generate(ct.Accent, dark),
+			base:    base,
+		}
+	}
+
+	return custom{
+		name:    ct.Name,
+		palette: ct.Palette,
+		base:    base,
+	}
+}
+
+// generate derives a palette from an accent colour. The accent is the cyan
+// colour used for highlights, the greys are shades of the accent and the
+// other colours are the standard colours blended with the accent.
+func generate(accent string, dark bool) config.Palette {
+	acc := gamut.Hex(accent)
+
+	mix := func(hex string) string {
+		return gamut.ToHex(gamut.Blends(gamut.Hex(hex), acc, accentBlends)[0])
+	}
+
+	shades := gamut.Shades(acc, 9)
+	tints := gamut.Tints(acc, 9)
+
+	bg, fg := shades[8], tints[8]
+	if !dark {
+		bg, fg = tints[8], shades[7]
+	}
+
+	return config.Palette{
+		Background:   gamut.ToHex(bg),
+		Foreground:   gamut.ToHex(fg),
+		Black:        gamut.ToHex(shades[6]),
+		Red:          mix(ansi.Red),
+		Green:        mix(ansi.Green),
+		Yellow:       mix(ansi.Yellow),
+		Blue:         mix(ansi.Blue),
+		Purple:       mix(ansi.Purple),
+		Cyan:         gamut.ToHex(acc),
+		White:        gamut.ToHex(tints[5]),
+		BrightBlack:  gamut.ToHex(gamut.Tones(acc, 9)[6]),
+		BrightRed:    mix(ansi.BrightRed),
+		BrightGreen:  mix(ansi.BrightGreen),
+		BrightYellow: mix(ansi.BrightYellow),
+		BrightBlue:   mix(ansi.BrightBlue),
+		BrightPurple: mix(ansi.BrightPurple),
+		BrightCyan:   gamut.ToHex(gamut.Lighter(acc, 0.2)),
+		BrightWhite:  gamut.ToHex(tints[7]),
+	}
+}
+
+func (t custom) DisplayName() string {
+	return t.name
+}
+
+func (t custom) ID() string {
+	return t.name
+}
+
+func (t custom) About() string {
+	return "Custom theme."
+}
+
+//nolint:ireturn
+func (t custom) Fg() lipgloss.TerminalColor {
+	return t.colour(t.palette.Foreground, t.base.Fg)
+}
+
+//nolint:ireturn
+func (t custom) Bg() lipgloss.TerminalColor {
+	return t.colour(t.palette.Background, t.base.Bg)
+}
+
+//nolint:ireturn
+func (t custom) SelectionBg() lipgloss.TerminalColor {
+	return t.colour(t.palette.BrightBlack, t.base.SelectionBg)
+}
+
+//nolint:ireturn
+func (t custom) Cursor() lipgloss.TerminalColor {
+	return t.colour(t.palette.Foreground, t.base.Cursor)
+}
+
+//nolint:ireturn
+func (t custom) Black() lipgloss.TerminalColor {
+	return t.colour(t.palette.Black, t.base.Black)
+}
+
+//nolint:ireturn
+func (t custom) Red() lipgloss.TerminalColor {
+	return t.colour(t.palette.Red, t.base.Red)
+}
+
+//nolint:ireturn
+func (t custom) Green() lipgloss.TerminalColor {
+	return t.colour(t.palette.Green, t.base.Green)
+}
+
+//nolint:ireturn
+func (t custom) Yellow() lipgloss.TerminalColor {
+	return t.colour(t.palette.Yellow, t.base.Yellow)
+}
+
+//nolint:ireturn
+func (t custom) Blue() lipgloss.TerminalColor {
+	return t.colour(t.palette.Blue, t.base.Blue)
+}
+
+//nolint:ireturn
+func (t custom) Purple() lipgloss.TerminalColor {
+	return t.colour(t.palette.Purple, t.base.Purple)
+}
+
+//nolint:ireturn
+func (t custom) Cyan() lipgloss.TerminalColor {
+	return t.colour(t.palette.Cyan, t.base.Cyan)
+}
+
+//nolint:ireturn
+func (t custom) White() lipgloss.TerminalColor {
+	return t.colour(t.palette.White, t.base.White)
+}
+
+//nolint:ireturn
+func (t custom) BrightBlack() lipgloss.TerminalColor {
+	return t.colour(t.palette.BrightBlack, t.base.BrightBlack)
+}
+
+//nolint:ireturn
+func (t custom) BrightRed() lipgloss.TerminalColor {
+	return t.colour(t.palette.BrightRed, t.base.BrightRed)
+}
+
+//nolint:ireturn
+func (t custom) BrightGreen() lipgloss.TerminalColor {
+	return t.colour(t.palette.BrightGreen, t.base.BrightGreen)
+}
+
+//nolint:ireturn
+func (t custom) BrightYellow() lipgloss.TerminalColor {
+	return t.colour(t.palette.BrightYellow, t.base.BrightYellow)
+}
+
+//nolint:ireturn
+func (t custom) BrightBlue() lipgloss.TerminalColor {
+	return t.colour(t.palette.BrightBlue, t.base.BrightBlue)
+}
+
+//nolint:ireturn
+func (t custom) BrightPurple() lipgloss.TerminalColor {
+	return t.colour(t.palette.BrightPurple, t.base.BrightPurple)
+}
+
+//nolint:ireturn
+func (t custom) BrightCyan() lipgloss.TerminalColor {
+	return t.colour(t.palette.BrightCyan, t.base.BrightCyan)
+}
+
+//nolint:ireturn
+func (t custom) BrightWhite() lipgloss.TerminalColor {
+	return t.colour(t.palette.BrightWhite, t.base.BrightWhite)
+}
+
+//nolint:ireturn
+func (t custom) colour(hex string, fallback func() lipgloss.TerminalColor) lipgloss.TerminalColor {
+	if hex == "" {
+		return fallback()
+	}
+
+	return lipgloss.Color(hex)
+}
@@ -14,8 +14,11 @@ type Theme struct {
 	Registry *tint.Registry
 }
 
-func New(clr config.Colour) Theme {
-	ts := tints(clr)
+// New returns the themes for the colour of the background. Custom themes
+// are added to the built-in themes and change the colours of any built-in
+// theme with the same ID.
+func New(clr config.Colour, themes ...config.CustomTheme) Theme {
+	ts := tints(clr, themes)
 	reg := tint.NewRegistry(ts[0], ts[1:]...)
 
 	return Theme{
@@ -62,20 +65,56 @@ func (t *Theme) List() []tint.Tint {
 	return t.Registry.Tints()
 }
 
-func tints(clr config.Colour) []tint.Tint {
-	if clr == config.ColourDark {
-		return dark()
+// IDs returns the ID of every theme for both dark and light backgrounds.
+func IDs() []string {
+	var ids []string
+
+	for _, t := range append(dark(), light()...) {
+		ids = append(ids, t.ID())
+	}
+
+	return ids
+}
+
+func tints(clr config.Colour, themes []config.CustomTheme) []tint.Tint {
+	dk := clr == config.ColourDark
+
+	if clr != config.ColourDark && clr != config.ColourLight {
+		dk = termenv.NewOutput(os.Stdout).HasDarkBackground()
+	}
+
+	ts := light()
+	if dk {
+		ts = dark()
 	}
 
-	if clr == config.ColourLight {
-		return light()
+	for _, ct := range themes {
+		if (dk && !ct.Dark()) || (!dk && !ct.Light()) {
+			continue
+		}
+
+		// A theme with the ID of another theme changes its colours.
+		i := index(ts, ct.Name)
+		if i < 0 {
+			ts = append(ts, newCustom(ct, dk, ts[0]))
+
+			continue
+		}
+
+		ts[i] = newCustom(ct, dk, ts[i])
 	}
 
-	if termenv.NewOutput(os.Stdout).HasDarkBackground() {
-		return dark()
+	return ts
+}
+
+func index(ts []tint.Tint, id string) int {
+	for i, t := range ts {
+		if t.ID() == id {
+			return i
+		}
 	}
 
-	return light()
+	return -1
 }
 
 func dark() []tint.Tint {
@@ -6,6 +6,8 @@ import (
 	"github.com/mikelorant/committed/internal/config"
 	"github.com/mikelorant/committed/internal/theme"
 
+	"github.com/charmbracelet/lipgloss"
+	tint "github.com/lrstanley/bubbletint"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -215,3 +217,124 @@ func TestSet(t *testing.T) {
 		})
 	}
 }
+
+func TestIDs(t *testing.T) {
+	t.Parallel()
+
+	ids := theme.IDs()
+
+	assert.Contains(t, ids, "builtin_dark")
+	assert.Contains(t, ids, "builtin_light")
+	assert.Contains(t, ids, "nord")
+	assert.NotContains(t, ids, "test")
+}
+
+func TestCustom(t *testing.T) {
+	t.Parallel()
+
+	themes := []config.CustomTheme{
+		{Name: "acme", Accent: "#0a84ff"},
+		{
+			Name:   "mocha",
+			Colour: config.ColourDark,
+			Palette: config.Palette{
+				Background: "#1e1e2e",
+				Red:        "#f38ba8",
+			},
+		},
+		{Name: "latte", Colour: config.ColourLight},
+		{Name: "nord", Palette: config.Palette{Blue: "#5e81ac"}},
+	}
+
+	tests := []struct {
+		name   string
+		colour config.Colour
+		id     string
+		ids    []string
+		check  func(*testing.T, theme.Theme)
+	}{
+		{
+			name:   "dark",
+			colour: config.ColourDark,
+			id:     "mocha",
+			ids: []string{
+				"acme",
+				"builtin_dark",
+				"dracula",
+				"gruvbox_dark",
+				"mocha",
+				"nord",
+				"retrowave",
+				"solarized_dark_higher_contrast",
+				"tokyo_night",
+			},
+			check: func(t *testing.T, th theme.Theme) {
+				t.Helper()
+
+				assert.Equal(t, lipgloss.Color("#1e1e2e"), th.Registry.Bg())
+				assert.Equal(t, lipgloss.Color("#f38ba8"), th.Registry.Red())
+				assert.Equal(t, tint.TintBuiltinDark.Green(), th.Registry.Green())
+			},
+		},
+		{
+			name:   "light",
+			colour: config.ColourLight,
+			id:     "latte",
+			ids: []string{
+				"acme",
+				"builtin_light",
+				"builtin_solarized_light",
+				"builtin_tango_light",
+				"gruvbox_light",
+				"latte",
+				"nord",
+				"tokyo_night_light",
+			},
+			check: func(t *testing.T, th theme.Theme) {
+				t.Helper()
+
+				assert.Equal(t, tint.TintBuiltinLight.Fg(), th.Registry.Fg())
+			},
+		},
+		{
+			name:   "accent",
+			colour: config.ColourDark,
+			id:     "acme",
+			check: func(t *testing.T, th theme.Theme) {
+				t.Helper()
+
+				assert.Equal(t, lipgloss.Color("#0a84ff"), th.Registry.Cyan())
+				assert.NotEqual(t, tint.TintBuiltinDark.Red(), th.Registry.Red())
+				assert.NotEqual(t, th.Registry.Fg(), th.Registry.Bg())
+			},
+		},
+		{
+			name:   "replace",
+			colour: config.ColourDark,
+			id:     "nord",
+			check: func(t *testing.T, th theme.Theme) {
+				t.Helper()
+
+				assert.Equal(t, lipgloss.Color("#5e81ac"), th.Registry.Blue())
+				assert.Equal(t, tint.TintNord.Red(), th.Registry.Red())
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			th := theme.New(tt.colour, themes...)
+
+			if tt.ids != nil {
+				assert.Equal(t, tt.ids, th.ListID())
+			}
+
+			assert.True(t, th.Set(tt.id))
+			tt.check(t, th)
+		})
+	}
+}
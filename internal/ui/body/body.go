@@ -4,11 +4,13 @@ import (
 	"strings"
 
 	"github.com/mikelorant/committed/internal/commit"
+	"github.com/mikelorant/committed/internal/config"
 	"github.com/mikelorant/committed/internal/ui/colour"
+	"github.com/mikelorant/committed/internal/vim"
+	"github.com/mikelorant/committed/internal/wrap"
 
 	"github.com/charmbracelet/bubbles/textarea"
 	tea "github.com/charmbracelet/bubbletea"
-	"github.com/muesli/reflow/wordwrap"
 )
 
 type Model struct {
@@ -19,6 +21,8 @@ type Model struct {
 	state    *commit.State
 	styles   Styles
 	textArea textarea.Model
+	modal    bool
+	vim      vim.Editor
 }
 
 const (
@@ -34,6 +38,8 @@ func New(state *commit.State, h int) Model {
 		state:    state,
 		styles:   defaultStyles(state.Theme),
 		textArea: newTextArea(state.Placeholders.Body, defaultWidth, state),
+		modal:    state.Config.View.EditMode == config.EditModeVim,
+		vim:      vim.New(false),
 	}
 
 	return m
@@ -48,6 +54,13 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	var cmd tea.Cmd
 	var cmds []tea.Cmd
 
+	if key, ok := msg.(tea.KeyMsg); ok && m.focus && m.modal && m.textArea.Focused() {
+		if b, ok := m.vim.Update(key.String(), m.buffer()); ok {
+			m.setBuffer(b)
+			msg = nil
+		}
+	}
+
 	if m.focus {
 		//nolint:gocritic
 		switch msg := msg.(type) {
@@ -113,19 +126,9 @@ func (m Model) Focused() bool {
 }
 
 func (m Model) Value() string {
-	w := wordwrap.WordWrap{
-		// Further details for the text reflow issue:
-		// https://github.com/charmbracelet/bubbles/issues/333
-		Limit:        m.Width - 1,
-		Breakpoints:  []rune{'-'},
-		Newline:      []rune{'\n'},
-		KeepNewlines: true,
-	}
-
-	w.Write([]byte(m.textArea.Value()))
-	w.Close()
-
-	return strings.TrimSpace(w.String())
+	// Further details for the text reflow issue:
+	// https://github.com/charmbracelet/bubbles/issues/333
+	return strings.TrimSpace(wrap.Format(m.textArea.Value(), m.Width-1))
 }
 
 func (m Model) RawValue() string {
@@ -147,6 +150,51 @@ func (m *Model) CursorStart() {
 	}
 }
 
+// Reflow joins and wraps the paragraph at the cursor, which is moved to the
+// end of the paragraph.
+func (m *Model) Reflow() {
+	v, row := wrap.Reflow(m.textArea.Value(), m.Width-1, m.textArea.Line())
+	if v == m.textArea.Value() {
+		return
+	}
+
+	lines := strings.Split(v, "\n")
+	m.setBuffer(vim.NewBuffer(v, row, len([]rune(lines[row]))))
+}
+
+// Mode returns the vim mode, or nothing when modal editing is not used.
+func (m Model) Mode() string {
+	if !m.modal {
+		return ""
+	}
+
+	return m.vim.Mode().String()
+}
+
+func (m Model) buffer() vim.Buffer {
+	li := m.textArea.LineInfo()
+
+	return vim.NewBuffer(m.textArea.Value(), m.textArea.Line(), li.StartColumn+li.ColumnOffset)
+}
+
+// setBuffer replaces the text and moves the cursor to the line and column of
+// the buffer.
+func (m *Model) setBuffer(b vim.Buffer) {
+	if v := b.String(); v != m.textArea.Value() {
+		m.textArea.SetValue(v)
+	}
+
+	for m.textArea.Line() > b.Row {
+		m.textArea.CursorUp()
+	}
+
+	for m.textArea.Line() < b.Row {
+		m.textArea.CursorDown()
+	}
+
+	m.textArea.SetCursor(b.Col)
+}
+
 func ToModel(m tea.Model, c tea.Cmd) (Model, tea.Cmd) {
 	return m.(Model), c
 }
@@ -190,6 +190,44 @@ func TestModel(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "reflow_list",
+			args: args{
+				model: func(m body.Model) body.Model {
+					m.Height = 5
+					m.Width = 16
+
+					m.SetValue("- one two three four five six")
+					m, _ = body.ToModel(m.Update(m))
+					return m
+				},
+			},
+			want: want{
+				model: func(m body.Model) {
+					assert.Equal(t, "- one two three\n  four five six", m.Value())
+				},
+			},
+		},
+		{
+			name: "reflow_paragraph",
+			args: args{
+				model: func(m body.Model) body.Model {
+					m.Focus()
+					m.Height = 5
+					m.Width = 16
+
+					m.SetValue("one\ntwo three four five six")
+					m.Reflow()
+					m, _ = body.ToModel(m.Update(nil))
+					return m
+				},
+			},
+			want: want{
+				model: func(m body.Model) {
+					assert.Equal(t, "one two three\nfour five six", m.RawValue())
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
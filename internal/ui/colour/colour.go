@@ -38,7 +38,14 @@ type filterlist struct {
 }
 
 type footer struct {
-	View lipgloss.TerminalColor
+	View                  lipgloss.TerminalColor
+	FocusBoundary         lipgloss.TerminalColor
+	Key                   lipgloss.TerminalColor
+	Selected              lipgloss.TerminalColor
+	InputPromptStyle      lipgloss.TerminalColor
+	InputTextStyle        lipgloss.TerminalColor
+	InputPlaceholderStyle lipgloss.TerminalColor
+	InputCursorStyle      lipgloss.TerminalColor
 }
 
 type header struct {
@@ -46,6 +53,7 @@ type header struct {
 	EmojiFocusBoundary           lipgloss.TerminalColor
 	SummaryBoundary              lipgloss.TerminalColor
 	SummaryFocusBoundary         lipgloss.TerminalColor
+	SummaryPrefix                lipgloss.TerminalColor
 	CounterDivider               lipgloss.TerminalColor
 	CounterLimit                 lipgloss.TerminalColor
 	SummaryInputPromptStyle      lipgloss.TerminalColor
@@ -83,6 +91,8 @@ type info struct {
 	AuthorValue         lipgloss.TerminalColor
 	DateText            lipgloss.TerminalColor
 	DateValue           lipgloss.TerminalColor
+	ConflictsText       lipgloss.TerminalColor
+	ConflictsValue      lipgloss.TerminalColor
 }
 
 type message struct {
@@ -96,6 +106,12 @@ type shortcut struct {
 	AngleBracket lipgloss.TerminalColor
 }
 
+type status struct {
+	Error   lipgloss.TerminalColor
+	Warning lipgloss.TerminalColor
+	Text    lipgloss.TerminalColor
+}
+
 type Colour struct {
 	registry *tint.Registry
 }
@@ -148,7 +164,14 @@ func (c *Colour) Footer() footer {
 	clr := c.registry
 
 	return footer{
-		View: clr.Fg(),
+		View:                  clr.Fg(),
+		FocusBoundary:         clr.Fg(),
+		Key:                   ToAdaptive(clr.Cyan()),
+		Selected:              ToAdaptive(clr.Green()),
+		InputPromptStyle:      ToAdaptive(clr.Cyan()),
+		InputTextStyle:        clr.Fg(),
+		InputPlaceholderStyle: ToAdaptive(clr.BrightBlack()),
+		InputCursorStyle:      clr.Fg(),
 	}
 }
 
@@ -161,6 +184,7 @@ func (c *Colour) Header() header {
 		EmojiFocusBoundary:           clr.Fg(),
 		SummaryBoundary:              ToAdaptive(clr.BrightBlack()),
 		SummaryFocusBoundary:         clr.Fg(),
+		SummaryPrefix:                ToAdaptive(clr.Cyan()),
 		CounterDivider:               clr.Fg(),
 		CounterLimit:                 clr.Fg(),
 		SummaryInputPromptStyle:      clr.Fg(),
@@ -208,6 +232,8 @@ func (c *Colour) Info() info {
 		AuthorValue:         clr.Fg(),
 		DateText:            clr.Fg(),
 		DateValue:           clr.Fg(),
+		ConflictsText:       clr.Fg(),
+		ConflictsValue:      ToAdaptive(clr.BrightRed()),
 	}
 }
 
@@ -232,6 +258,17 @@ func (c *Colour) Shortcut() shortcut {
 	}
 }
 
+//nolint:revive
+func (c *Colour) Status() status {
+	clr := c.registry
+
+	return status{
+		Error:   ToAdaptive(clr.BrightRed()),
+		Warning: ToAdaptive(clr.Yellow()),
+		Text:    clr.Fg(),
+	}
+}
+
 func ToAdaptive(clr color.Color) lipgloss.AdaptiveColor {
 	return lipgloss.AdaptiveColor{
 		Dark:  ToDefault(clr),
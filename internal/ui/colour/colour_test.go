@@ -43,7 +43,14 @@ type filterlist struct {
 }
 
 type footer struct {
-	View Colour
+	View                  Colour
+	FocusBoundary         Colour
+	Key                   Colour
+	Selected              Colour
+	InputPromptStyle      Colour
+	InputTextStyle        Colour
+	InputPlaceholderStyle Colour
+	InputCursorStyle      Colour
 }
 
 type header struct {
@@ -51,6 +58,7 @@ type header struct {
 	EmojiFocusBoundary           Colour
 	SummaryBoundary              Colour
 	SummaryFocusBoundary         Colour
+	SummaryPrefix                Colour
 	CounterDivider               Colour
 	CounterLimit                 Colour
 	SummaryInputPromptStyle      Colour
@@ -101,6 +109,12 @@ type shortcut struct {
 	AngleBracket Colour
 }
 
+type status struct {
+	Error   Colour
+	Warning Colour
+	Text    Colour
+}
+
 func TestBody(t *testing.T) {
 	t.Parallel()
 
@@ -201,7 +215,14 @@ func TestFooter(t *testing.T) {
 		{
 			name: "Footer",
 			footer: footer{
-				View: Colour{Dark: "#bbbbbb"},
+				View:                  Colour{Dark: "#bbbbbb"},
+				FocusBoundary:         Colour{Dark: "#bbbbbb"},
+				Key:                   Colour{Dark: "#00bbbb", Light: "#bb0000"},
+				Selected:              Colour{Dark: "#00bb00", Light: "#bb00bb"},
+				InputPromptStyle:      Colour{Dark: "#00bbbb", Light: "#bb0000"},
+				InputTextStyle:        Colour{Dark: "#bbbbbb"},
+				InputPlaceholderStyle: Colour{Dark: "#555555", Light: "#555555"},
+				InputCursorStyle:      Colour{Dark: "#bbbbbb"},
 			},
 		},
 	}
@@ -214,7 +235,14 @@ func TestFooter(t *testing.T) {
 
 			clr := colour.New(theme.New(config.ColourAdaptive)).Footer()
 
-			assert.Equal(t, tt.footer.View, toColour(clr.View), "Boundary")
+			assert.Equal(t, tt.footer.View, toColour(clr.View), "View")
+			assert.Equal(t, tt.footer.FocusBoundary, toColour(clr.FocusBoundary), "FocusBoundary")
+			assert.Equal(t, tt.footer.Key, toColour(clr.Key), "Key")
+			assert.Equal(t, tt.footer.Selected, toColour(clr.Selected), "Selected")
+			assert.Equal(t, tt.footer.InputPromptStyle, toColour(clr.InputPromptStyle), "InputPromptStyle")
+			assert.Equal(t, tt.footer.InputTextStyle, toColour(clr.InputTextStyle), "InputTextStyle")
+			assert.Equal(t, tt.footer.InputPlaceholderStyle, toColour(clr.InputPlaceholderStyle), "InputPlaceholderStyle")
+			assert.Equal(t, tt.footer.InputCursorStyle, toColour(clr.InputCursorStyle), "InputCursorStyle")
 		})
 	}
 }
@@ -233,6 +261,7 @@ func TestHeader(t *testing.T) {
 				EmojiFocusBoundary:           Colour{Dark: "#bbbbbb"},
 				SummaryBoundary:              Colour{Dark: "#555555", Light: "#555555"},
 				SummaryFocusBoundary:         Colour{Dark: "#bbbbbb"},
+				SummaryPrefix:                Colour{Dark: "#00bbbb", Light: "#bb0000"},
 				CounterDivider:               Colour{Dark: "#bbbbbb"},
 				CounterLimit:                 Colour{Dark: "#bbbbbb"},
 				SummaryInputPromptStyle:      Colour{Dark: "#bbbbbb"},
@@ -265,6 +294,7 @@ func TestHeader(t *testing.T) {
 			assert.Equal(t, tt.header.EmojiFocusBoundary, toColour(clr.EmojiFocusBoundary), "EmojiFocusBoundary")
 			assert.Equal(t, tt.header.SummaryBoundary, toColour(clr.SummaryBoundary), "SummaryBoundary")
 			assert.Equal(t, tt.header.SummaryFocusBoundary, toColour(clr.SummaryFocusBoundary), "SummaryFocusBoundary")
+			assert.Equal(t, tt.header.SummaryPrefix, toColour(clr.SummaryPrefix), "SummaryPrefix")
 			assert.Equal(t, tt.header.CounterDivider, toColour(clr.CounterDivider), "CounterDivider")
 			assert.Equal(t, tt.header.CounterLimit, toColour(clr.CounterLimit), "CounterLimit")
 			assert.Equal(t, tt.header.SummaryInputPromptStyle, toColour(clr.SummaryInputPromptStyle), "SummaryInputPromptStyle")
@@ -424,6 +454,38 @@ func TestShortcut(t *testing.T) {
 	}
 }
 
+func TestStatus(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		status status
+	}{
+		{
+			name: "Status",
+			status: status{
+				Error:   Colour{Dark: "#ff5555", Light: "#55ffff"},
+				Warning: Colour{Dark: "#bbbb00", Light: "#0000bb"},
+				Text:    Colour{Dark: "#bbbbbb"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			clr := colour.New(theme.New(config.ColourAdaptive)).Status()
+
+			assert.Equal(t, tt.status.Error, toColour(clr.Error), "Error")
+			assert.Equal(t, tt.status.Warning, toColour(clr.Warning), "Warning")
+			assert.Equal(t, tt.status.Text, toColour(clr.Text), "Text")
+		})
+	}
+}
+
 func toColour(clr lipgloss.TerminalColor) Colour {
 	switch clr := clr.(type) {
 	case lipgloss.AdaptiveColor:
@@ -10,7 +10,7 @@ func (m *Model) defaults(cfg config.Config) {
 	m.defaultEmojiType(cfg.Commit.EmojiType)
 	m.defaultFocus(cfg.View.Focus)
 	m.defaultSignoff(cfg.Commit.Signoff)
-	m.defaultTheme(cfg.View.Theme, cfg.View.Colour)
+	m.defaultTheme(cfg.View.Theme, cfg.View.Colour, cfg.Themes)
 }
 
 func (m *Model) defaultEmojiType(et config.EmojiType) {
@@ -30,12 +30,18 @@ func (m *Model) defaultFocus(focus config.Focus) {
 	}
 }
 
+func (m *Model) defaultSource(file commit.File) {
+	if len(file.Squash) > 0 {
+		m.focus = squashComponent
+	}
+}
+
 func (m *Model) defaultSignoff(signoff bool) {
 	m.signoff = signoff
 }
 
-func (m *Model) defaultTheme(th string, clr config.Colour) {
-	t := theme.New(clr)
+func (m *Model) defaultTheme(th string, clr config.Colour, themes []config.CustomTheme) {
+	t := theme.New(clr, themes...)
 	t.Set(th)
 
 	m.state.Theme = t
@@ -43,12 +49,14 @@ func (m *Model) defaultTheme(th string, clr config.Colour) {
 
 func defaultAmendSave(st *commit.State) savedState {
 	s := savedState{
-		amend:   true,
-		summary: commit.MessageToSummary(st.Repository.Head.Message),
-		body:    commit.MessageToBody(st.Repository.Head.Message),
+		amend:        true,
+		summary:      commit.MessageToSummary(st.Repository.Head.Message, st.Config.Commit),
+		conventional: messageToConventional(st, st.Repository.Head.Message),
+		body:         commit.TrimTrailers(commit.MessageToBody(st.Repository.Head.Message)),
+		trailers:     commit.MessageToTrailers(st.Repository.Head.Message),
 	}
 
-	if e := commit.MessageToEmoji(st.Emojis, st.Repository.Head.Message); e.Valid {
+	if e := commit.MessageToEmoji(st.Emojis, st.Repository.Head.Message, st.Config.Commit); e.Valid {
 		s.emoji = e.Emoji
 	}
 
@@ -58,14 +66,34 @@ func defaultAmendSave(st *commit.State) savedState {
 func defaultHookEditorSave(st *commit.State) savedState {
 	msg := st.File.Message
 
+	// Squashed subjects are chosen with the picker instead.
+	if len(st.File.Squash) > 0 {
+		return savedState{
+			body:     commit.SquashToBody(msg),
+			trailers: commit.SquashToTrailers(msg),
+		}
+	}
+
 	s := savedState{
-		summary: commit.TrimComments(commit.MessageToSummary(msg)),
-		body:    commit.TrimComments(commit.MessageToBody(msg)),
+		summary:      commit.TrimComments(commit.MessageToSummary(msg, st.Config.Commit)),
+		conventional: messageToConventional(st, msg),
+		body:         commit.TrimComments(commit.TrimTrailers(commit.MessageToBody(msg))),
+		trailers:     commit.MessageToTrailers(msg),
 	}
 
-	if e := commit.MessageToEmoji(st.Emojis, msg); e.Valid {
+	if e := commit.MessageToEmoji(st.Emojis, msg, st.Config.Commit); e.Valid {
 		s.emoji = e.Emoji
 	}
 
 	return s
 }
+
+// messageToConventional returns the type and scope of a message with the
+// conventional style. Otherwise the prefix is kept within the summary.
+func messageToConventional(st *commit.State, msg string) commit.Conventional {
+	if st.Config.Commit.Style != config.StyleConventional {
+		return commit.Conventional{}
+	}
+
+	return commit.MessageToConventional(msg, commit.Types(st.Config.Commit))
+}
@@ -0,0 +1,79 @@
+package ui
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/mikelorant/committed/internal/shell"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// editorFile is named as Git names the message file so that editors use
+// their commit message settings, such as spellcheck.
+const editorFile = "COMMIT_EDITMSG"
+
+// editorMsg is sent once the editor of the body exits.
+type editorMsg struct {
+	dir string
+	err error
+}
+
+// editBody suspends the program and opens the body in the preferred editor
+// for commit messages. The program resumes when the editor exits.
+func (m Model) editBody() tea.Cmd {
+	dir, err := os.MkdirTemp("", "committed-")
+	if err != nil {
+		return editorErr(fmt.Errorf("unable to create directory: %w", err))
+	}
+
+	file := filepath.Join(dir, editorFile)
+
+	if err := os.WriteFile(file, []byte(m.models.body.RawValue()+"\n"), 0o600); err != nil {
+		os.RemoveAll(dir)
+
+		return editorErr(fmt.Errorf("unable to write message: %w", err))
+	}
+
+	return tea.ExecProcess(shell.EditMessageCommand(file), func(err error) tea.Msg {
+		if err != nil {
+			err = fmt.Errorf("unable to run editor: %w", err)
+		}
+
+		return editorMsg{dir: dir, err: err}
+	})
+}
+
+// onEditorExit replaces the body with the edited message. The body is kept
+// when the editor fails and the error is shown in the status.
+func (m Model) onEditorExit(msg editorMsg) Model {
+	if msg.dir != "" {
+		defer os.RemoveAll(msg.dir)
+	}
+
+	if msg.err != nil {
+		m.statusErr = msg.err
+
+		return m
+	}
+
+	out, err := os.ReadFile(filepath.Join(msg.dir, editorFile))
+	if err != nil {
+		m.statusErr = fmt.Errorf("unable to read message: %w", err)
+
+		return m
+	}
+
+	m.models.body.SetValue(strings.TrimRight(string(out), "\n"))
+	m.focus = bodyComponent
+
+	return m
+}
+
+func editorErr(err error) tea.Cmd {
+	return func() tea.Msg {
+		return editorMsg{err: err}
+	}
+}
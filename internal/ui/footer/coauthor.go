@@ -0,0 +1,118 @@
+package footer
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/mikelorant/committed/internal/commit"
+	"github.com/mikelorant/committed/internal/fuzzy"
+	"github.com/mikelorant/committed/internal/repository"
+
+	"github.com/charmbracelet/bubbles/list"
+)
+
+type listItem struct {
+	author   repository.User
+	selected bool
+}
+
+type fuzzyItem struct {
+	author repository.User
+}
+
+const (
+	coAuthorKey = "Co-authored-by"
+
+	selectedItem   = "✓"
+	unselectedItem = " "
+)
+
+func (i listItem) Title() string {
+	mark := unselectedItem
+	if i.selected {
+		mark = selectedItem
+	}
+
+	return fmt.Sprintf("%s %s", mark, userToValue(i.author))
+}
+
+func (i listItem) Description() string {
+	return i.author.Name
+}
+
+func (i listItem) FilterValue() string {
+	return i.author.Name
+}
+
+func (i fuzzyItem) Fields() []fuzzy.Field {
+	return []fuzzy.Field{
+		{Value: i.author.Name, Weight: fuzzy.WeightHigh},
+		{Value: i.author.Email, Weight: fuzzy.WeightMedium},
+	}
+}
+
+// coAuthors returns every distinct author from the repository history,
+// the configured authors and the repository users.
+func coAuthors(state *commit.State) []repository.User {
+	var users []repository.User
+
+	seen := make(map[string]bool)
+
+	all := concatSlice(state.Repository.Authors, state.Config.Authors)
+	all = concatSlice(all, state.Repository.Users)
+
+	for _, u := range all {
+		key := strings.ToLower(u.Email)
+		if u.Name == "" || u.Email == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		users = append(users, repository.User{
+			Name:  u.Name,
+			Email: u.Email,
+		})
+	}
+
+	return users
+}
+
+// coAuthor reports if an author may be a co-author, which excludes the
+// commit author.
+func (m Model) coAuthor(u repository.User) bool {
+	return !strings.EqualFold(u.Email, m.Author.Email)
+}
+
+func coAuthorTrailer(u repository.User) repository.Trailer {
+	return repository.Trailer{
+		Key:   coAuthorKey,
+		Value: userToValue(u),
+	}
+}
+
+func userToValue(u repository.User) string {
+	return fmt.Sprintf("%s <%s>", u.Name, u.Email)
+}
+
+func (m Model) castToListItems(authors []repository.User) []list.Item {
+	res := make([]list.Item, len(authors))
+	for i, a := range authors {
+		res[i] = listItem{
+			author:   a,
+			selected: m.hasTrailer(coAuthorTrailer(a)),
+		}
+	}
+
+	return res
+}
+
+func castToFuzzyItems(authors []repository.User) []fuzzy.Item {
+	res := make([]fuzzy.Item, len(authors))
+	for i, a := range authors {
+		res[i] = fuzzyItem{
+			author: a,
+		}
+	}
+
+	return res
+}
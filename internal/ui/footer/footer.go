@@ -2,22 +2,58 @@ package footer
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/mikelorant/committed/internal/commit"
+	"github.com/mikelorant/committed/internal/fuzzy"
+	"github.com/mikelorant/committed/internal/keymap"
 	"github.com/mikelorant/committed/internal/repository"
 	"github.com/mikelorant/committed/internal/ui/colour"
+	"github.com/mikelorant/committed/internal/ui/filterlist"
 
+	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 )
 
 type Model struct {
-	Author  repository.User
-	Signoff bool
+	Author   repository.User
+	Signoff  bool
+	Trailers []repository.Trailer
+	Authors  []repository.User
 
-	state *commit.State
+	focus      bool
+	picker     bool
+	selected   int
+	key        int
+	editing    bool
+	position   int
+	state      *commit.State
+	styles     Styles
+	input      textinput.Model
+	authorList filterlist.Model
+	index      *fuzzy.Index
 }
 
+const (
+	signoffKey = "Signed-off-by"
+	customKey  = "Custom"
+)
+
+const (
+	authorHeight     = 9
+	authorPromptText = "Choose co-authors:"
+)
+
+const (
+	inputWidth    = 50
+	customPrompt  = "Key: value"
+	userPrompt    = "Name <email>"
+	issuePrompt   = "#123"
+	changePrompt  = "I1234567890abcdef"
+	defaultPrompt = "value"
+)
+
 func New(state *commit.State) Model {
 	authors := concatSlice(state.Repository.Users, state.Config.Authors)
 
@@ -25,10 +61,26 @@ func New(state *commit.State) Model {
 		authors = []repository.User{{}}
 	}
 
-	return Model{
-		Author: authors[0],
-		state:  state,
+	m := Model{
+		Author:  authors[0],
+		Authors: coAuthors(state),
+		state:   state,
+		styles:  defaultStyles(state.Theme),
+		input:   trailerInput(state),
 	}
+
+	m.index = fuzzy.NewIndex(castToFuzzyItems(m.Authors))
+
+	m.authorList = filterlist.New(
+		m.castToListItems(m.Authors),
+		authorPromptText,
+		authorHeight,
+		state,
+	)
+
+	m.setPrompt()
+
+	return m
 }
 
 func (m Model) Init() tea.Cmd {
@@ -37,38 +89,427 @@ func (m Model) Init() tea.Cmd {
 
 //nolint:ireturn
 func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
-	return m, nil
+	var cmd tea.Cmd
+
+	if !m.focus && m.picker {
+		m.closePicker()
+	}
+
+	if m.focus && m.picker {
+		return m.updatePicker(msg)
+	}
+
+	if m.focus {
+		//nolint:gocritic
+		switch msg := msg.(type) {
+		case tea.KeyMsg:
+			key := msg.String()
+
+			switch {
+			case key == "up":
+				if m.selected > 0 {
+					m.selected--
+				}
+				return m, nil
+			case key == "down":
+				if m.selected < len(m.Trailers) {
+					m.selected++
+				}
+				return m, nil
+			case m.state.Keymap.Matches(key, keymap.CoAuthors):
+				return m.openPicker()
+			case m.state.Keymap.Matches(key, keymap.TrailerKey):
+				m.key = (m.key + 1) % len(keys())
+				m.setPrompt()
+				return m, nil
+			case key == "enter":
+				if m.selected < len(m.Trailers) {
+					m.edit(m.selected)
+					return m, nil
+				}
+				m.add()
+				return m, nil
+			case key == "delete", key == "backspace":
+				if m.selected < len(m.Trailers) {
+					m.remove(m.selected)
+					return m, nil
+				}
+			}
+		}
+	}
+
+	//nolint:gocritic
+	switch msg.(type) {
+	case colour.Msg:
+		m.styles = defaultStyles(m.state.Theme)
+		styleTrailerInput(&m.input, m.state)
+		m.authorList, _ = filterlist.ToModel(m.authorList.Update(msg))
+	}
+
+	switch {
+	case m.focus && !m.input.Focused():
+		cmd = m.input.Focus()
+		return m, cmd
+	case !m.focus && m.input.Focused():
+		m.finishEdit()
+		m.input.Blur()
+		return m, nil
+	}
+
+	if m.selected != len(m.Trailers) {
+		return m, nil
+	}
+
+	m.input, cmd = m.input.Update(msg)
+
+	return m, cmd
 }
 
 func (m Model) View() string {
-	clr := colour.New(m.state.Theme).Footer()
+	if m.focus && m.picker {
+		return m.styles.picker.Render(m.authorList.View())
+	}
+
+	if m.focus {
+		return m.styles.focusBoundary.Render(m.editor())
+	}
+
+	ts := m.Value()
+	if len(ts) == 0 {
+		return ""
+	}
+
+	return m.styles.view.
+		Height(len(ts)).
+		Render(repository.TrailersToString(ts))
+}
+
+func (m *Model) Focus() {
+	m.focus = true
+}
+
+func (m *Model) Blur() {
+	m.focus = false
+}
 
-	return lipgloss.NewStyle().
-		Width(74).
-		Height(1).
-		MarginLeft(4).
-		MarginBottom(1).
-		Align(lipgloss.Left, lipgloss.Center).
-		Border(lipgloss.HiddenBorder(), false, true).
-		Padding(0, 1, 0, 1).
-		Foreground(clr.View).
-		Render(m.signoff())
+func (m Model) Focused() bool {
+	return m.focus
 }
 
 func (m *Model) ToggleSignoff() {
 	m.Signoff = !m.Signoff
 }
 
-func (m Model) Value() string {
+func (m *Model) SetTrailers(ts []repository.Trailer) {
+	m.Trailers = append([]repository.Trailer{}, ts...)
+	m.selected = len(m.Trailers)
+	m.editing = false
+	m.input.Reset()
+}
+
+func (m *Model) Reset() {
+	m.SetTrailers(nil)
+}
+
+// Height is the number of lines the footer occupies including the margin.
+func (m Model) Height() int {
+	v := m.View()
+	if v == "" {
+		return 0
+	}
+
+	return lipgloss.Height(v)
+}
+
+func (m Model) Value() []repository.Trailer {
+	ts := concatSlice(m.Trailers, m.signoffTrailers())
+	if len(ts) == 0 {
+		return nil
+	}
+
+	return ts
+}
+
+// RawValue returns the trailers without the sign-off, as it is added from the
+// author when toggled.
+func (m Model) RawValue() []repository.Trailer {
+	if len(m.Trailers) == 0 {
+		return nil
+	}
+
+	return append([]repository.Trailer(nil), m.Trailers...)
+}
+
+func (m Model) signoffTrailers() []repository.Trailer {
 	if !m.Signoff {
-		return ""
+		return nil
 	}
 
-	return m.signoff()
+	so := m.signoff()
+
+	for _, t := range m.Trailers {
+		if t == so {
+			return nil
+		}
+	}
+
+	return []repository.Trailer{so}
+}
+
+func (m Model) signoff() repository.Trailer {
+	return repository.Trailer{
+		Key:   signoffKey,
+		Value: fmt.Sprintf("%s <%s>", m.Author.Name, m.Author.Email),
+	}
+}
+
+func (m Model) editor() string {
+	var rows []string
+
+	for i, t := range m.Trailers {
+		rows = append(rows, m.row(i == m.selected, t))
+	}
+
+	for _, t := range m.signoffTrailers() {
+		rows = append(rows, m.row(false, t))
+	}
+
+	mark := m.styles.unselected.String()
+	if m.selected == len(m.Trailers) {
+		mark = m.styles.selected.String()
+	}
+
+	rows = append(rows, lipgloss.JoinHorizontal(lipgloss.Top, mark, m.input.View()))
+
+	return lipgloss.JoinVertical(lipgloss.Left, rows...)
 }
 
-func (m Model) signoff() string {
-	return fmt.Sprintf("Signed-off-by: %s <%s>", m.Author.Name, m.Author.Email)
+func (m Model) row(selected bool, t repository.Trailer) string {
+	mark := m.styles.unselected.String()
+	if selected {
+		mark = m.styles.selected.String()
+	}
+
+	key := m.styles.key.Render(t.Key + ":")
+
+	return fmt.Sprintf("%s%s %s", mark, key, t.Value)
+}
+
+func (m *Model) add() {
+	t, ok := m.trailer()
+	if !ok {
+		return
+	}
+
+	switch {
+	case m.editing:
+		m.Trailers[m.position] = t
+		m.editing = false
+	default:
+		m.Trailers = append(m.Trailers, t)
+	}
+
+	m.selected = len(m.Trailers)
+	m.input.Reset()
+}
+
+// edit loads a trailer into the input. The trailer is kept in place until
+// the edit is added, so leaving the edit does not lose it.
+func (m *Model) edit(i int) {
+	m.finishEdit()
+
+	t := m.Trailers[i]
+
+	m.editing = true
+	m.position = i
+	m.key = len(keys()) - 1
+	m.input.SetValue(t.String())
+
+	for k, v := range keys() {
+		if v == t.Key {
+			m.key = k
+			m.input.SetValue(t.Value)
+		}
+	}
+
+	m.selected = len(m.Trailers)
+	m.setPrompt()
+	m.input.CursorEnd()
+}
+
+// finishEdit replaces the trailer being edited with the input, when the input
+// is a trailer, and clears the input.
+func (m *Model) finishEdit() {
+	if !m.editing {
+		return
+	}
+
+	if t, ok := m.trailer(); ok {
+		m.Trailers[m.position] = t
+	}
+
+	m.editing = false
+	m.input.Reset()
+}
+
+func (m Model) openPicker() (Model, tea.Cmd) {
+	var cmd tea.Cmd
+
+	m.picker = true
+	m.authorList.Focus()
+	m.setAuthorItems()
+	m.authorList, cmd = filterlist.ToModel(m.authorList.Update(nil))
+
+	return m, cmd
+}
+
+func (m *Model) closePicker() {
+	m.picker = false
+	m.authorList.Blur()
+	m.authorList, _ = filterlist.ToModel(m.authorList.Update(nil))
+}
+
+func (m Model) updatePicker(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmd tea.Cmd
+
+	//nolint:gocritic
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		key := msg.String()
+
+		switch {
+		case m.state.Keymap.Matches(key, keymap.CoAuthors):
+			m.closePicker()
+			return m, nil
+		case key == "enter":
+			if item, ok := m.authorList.SelectedItem().(listItem); ok {
+				m.toggle(coAuthorTrailer(item.author))
+				m.setAuthorItems()
+			}
+			return m, nil
+		}
+	}
+
+	m.authorList, cmd = filterlist.ToModel(m.authorList.Update(msg))
+	m.setAuthorItems()
+
+	return m, cmd
+}
+
+func (m *Model) setAuthorItems() {
+	var authors []repository.User
+
+	for _, rank := range m.index.Rank(m.authorList.Filter()) {
+		if m.coAuthor(m.Authors[rank]) {
+			authors = append(authors, m.Authors[rank])
+		}
+	}
+
+	m.authorList.SetItems(m.castToListItems(authors))
+}
+
+func (m *Model) toggle(t repository.Trailer) {
+	for i, v := range m.Trailers {
+		if v == t {
+			m.remove(i)
+			m.selected = len(m.Trailers)
+			return
+		}
+	}
+
+	m.Trailers = append(m.Trailers, t)
+	m.selected = len(m.Trailers)
+}
+
+func (m Model) hasTrailer(t repository.Trailer) bool {
+	for _, v := range m.Trailers {
+		if v == t {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (m *Model) remove(i int) {
+	m.Trailers = append(m.Trailers[:i:i], m.Trailers[i+1:]...)
+
+	switch {
+	case m.editing && i == m.position:
+		m.editing = false
+		m.input.Reset()
+	case m.editing && i < m.position:
+		m.position--
+	}
+
+	if m.selected > len(m.Trailers) {
+		m.selected = len(m.Trailers)
+	}
+}
+
+func (m Model) trailer() (repository.Trailer, bool) {
+	v := strings.TrimSpace(m.input.Value())
+	if v == "" {
+		return repository.Trailer{}, false
+	}
+
+	k := keys()[m.key]
+	if k == customKey {
+		return commit.ParseTrailer(v)
+	}
+
+	return repository.Trailer{
+		Key:   k,
+		Value: v,
+	}, true
+}
+
+func (m *Model) setPrompt() {
+	k := keys()[m.key]
+
+	switch k {
+	case customKey:
+		m.input.Prompt = ""
+		m.input.Placeholder = customPrompt
+	default:
+		m.input.Prompt = k + ": "
+		m.input.Placeholder = placeholder(k)
+	}
+}
+
+func keys() []string {
+	return concatSlice(commit.TrailerKeys, []string{customKey})
+}
+
+func placeholder(key string) string {
+	switch key {
+	case "Co-authored-by", "Reviewed-by":
+		return userPrompt
+	case "Fixes", "Refs":
+		return issuePrompt
+	case "Change-Id":
+		return changePrompt
+	}
+
+	return defaultPrompt
+}
+
+func trailerInput(state *commit.State) textinput.Model {
+	ti := textinput.New()
+	ti.Width = inputWidth
+
+	styleTrailerInput(&ti, state)
+
+	return ti
+}
+
+func styleTrailerInput(ti *textinput.Model, state *commit.State) {
+	s := defaultStyles(state.Theme)
+
+	ti.PromptStyle = s.inputPromptStyle
+	ti.TextStyle = s.inputTextStyle
+	ti.PlaceholderStyle = s.inputPlaceholderStyle
+	ti.Cursor.Style = s.inputCursorStyle
 }
 
 func ToModel(m tea.Model, c tea.Cmd) (Model, tea.Cmd) {
@@ -10,6 +10,7 @@ import (
 	"github.com/mikelorant/committed/internal/ui/footer"
 	"github.com/mikelorant/committed/internal/ui/uitest"
 
+	tea "github.com/charmbracelet/bubbletea"
 	"github.com/hexops/autogold/v2"
 	"github.com/stretchr/testify/assert"
 )
@@ -18,8 +19,21 @@ func TestModel(t *testing.T) {
 	t.Parallel()
 
 	type args struct {
-		author repository.User
-		model  func(m footer.Model) footer.Model
+		author   repository.User
+		authors  []repository.User
+		trailers []repository.Trailer
+		model    func(m footer.Model) footer.Model
+	}
+
+	coAuthors := []repository.User{
+		{Name: "Jane Doe", Email: "jane.doe@example.com"},
+		{Name: "Bob Smith", Email: "bob.smith@example.com"},
+		{Name: "Jane", Email: "Jane.Doe@example.com"},
+	}
+
+	signoff := repository.Trailer{
+		Key:   "Signed-off-by",
+		Value: "John Doe <john.doe@example.com>",
 	}
 
 	type want struct {
@@ -48,7 +62,7 @@ func TestModel(t *testing.T) {
 
 					assert.Equal(t, u, m.Author)
 					assert.Equal(t, false, m.Signoff)
-					assert.Equal(t, "", m.Value())
+					assert.Empty(t, m.Value())
 				},
 			},
 		},
@@ -74,7 +88,413 @@ func TestModel(t *testing.T) {
 
 					assert.Equal(t, u, m.Author)
 					assert.Equal(t, true, m.Signoff)
-					assert.Equal(t, "Signed-off-by: John Doe <john.doe@example.com>", m.Value())
+					assert.Equal(t, []repository.Trailer{signoff}, m.Value())
+				},
+			},
+		},
+		{
+			name: "focus",
+			args: args{
+				model: func(m footer.Model) footer.Model {
+					m.Focus()
+					m, _ = footer.ToModel(m.Update(nil))
+					return m
+				},
+			},
+			want: want{
+				model: func(m footer.Model) {
+					assert.True(t, m.Focused())
+					assert.Equal(t, 4, m.Height())
+				},
+			},
+		},
+		{
+			name: "add",
+			args: args{
+				model: func(m footer.Model) footer.Model {
+					m.Focus()
+					m, _ = footer.ToModel(m.Update(nil))
+					m, _ = footer.ToModel(uitest.SendString(m, "Jane Doe <jane.doe@example.com>"), nil)
+					m, _ = footer.ToModel(m.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+					return m
+				},
+			},
+			want: want{
+				model: func(m footer.Model) {
+					ts := []repository.Trailer{
+						{Key: "Co-authored-by", Value: "Jane Doe <jane.doe@example.com>"},
+					}
+
+					assert.Equal(t, ts, m.Value())
+					assert.Equal(t, 5, m.Height())
+				},
+			},
+		},
+		{
+			name: "add_key",
+			args: args{
+				model: func(m footer.Model) footer.Model {
+					m.Focus()
+					m, _ = footer.ToModel(m.Update(nil))
+					m, _ = footer.ToModel(m.Update(tea.KeyMsg{Type: tea.KeyCtrlT}))
+					m, _ = footer.ToModel(m.Update(tea.KeyMsg{Type: tea.KeyCtrlT}))
+					m, _ = footer.ToModel(uitest.SendString(m, "#123"), nil)
+					m, _ = footer.ToModel(m.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+					return m
+				},
+			},
+			want: want{
+				model: func(m footer.Model) {
+					ts := []repository.Trailer{
+						{Key: "Fixes", Value: "#123"},
+					}
+
+					assert.Equal(t, ts, m.Value())
+				},
+			},
+		},
+		{
+			name: "add_custom",
+			args: args{
+				model: func(m footer.Model) footer.Model {
+					m.Focus()
+					m, _ = footer.ToModel(m.Update(nil))
+					for i := 0; i < 5; i++ {
+						m, _ = footer.ToModel(m.Update(tea.KeyMsg{Type: tea.KeyCtrlT}))
+					}
+					m, _ = footer.ToModel(uitest.SendString(m, "Acked-by: Jane Doe"), nil)
+					m, _ = footer.ToModel(m.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+					return m
+				},
+			},
+			want: want{
+				model: func(m footer.Model) {
+					ts := []repository.Trailer{
+						{Key: "Acked-by", Value: "Jane Doe"},
+					}
+
+					assert.Equal(t, ts, m.Value())
+				},
+			},
+		},
+		{
+			name: "add_custom_invalid",
+			args: args{
+				model: func(m footer.Model) footer.Model {
+					m.Focus()
+					m, _ = footer.ToModel(m.Update(nil))
+					for i := 0; i < 5; i++ {
+						m, _ = footer.ToModel(m.Update(tea.KeyMsg{Type: tea.KeyCtrlT}))
+					}
+					m, _ = footer.ToModel(uitest.SendString(m, "invalid"), nil)
+					m, _ = footer.ToModel(m.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+					return m
+				},
+			},
+			want: want{
+				model: func(m footer.Model) {
+					assert.Empty(t, m.Value())
+				},
+			},
+		},
+		{
+			name: "edit",
+			args: args{
+				trailers: []repository.Trailer{
+					{Key: "Refs", Value: "#1"},
+					{Key: "Fixes", Value: "#2"},
+				},
+				model: func(m footer.Model) footer.Model {
+					m.Focus()
+					m, _ = footer.ToModel(m.Update(nil))
+					m, _ = footer.ToModel(m.Update(tea.KeyMsg{Type: tea.KeyUp}))
+					m, _ = footer.ToModel(m.Update(tea.KeyMsg{Type: tea.KeyUp}))
+					m, _ = footer.ToModel(m.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+					m, _ = footer.ToModel(uitest.SendString(m, "0"), nil)
+					m, _ = footer.ToModel(m.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+					return m
+				},
+			},
+			want: want{
+				model: func(m footer.Model) {
+					ts := []repository.Trailer{
+						{Key: "Refs", Value: "#10"},
+						{Key: "Fixes", Value: "#2"},
+					}
+
+					assert.Equal(t, ts, m.Value())
+				},
+			},
+		},
+		{
+			name: "edit_blur",
+			args: args{
+				trailers: []repository.Trailer{
+					{Key: "Refs", Value: "#1"},
+					{Key: "Fixes", Value: "#2"},
+				},
+				model: func(m footer.Model) footer.Model {
+					m.Focus()
+					m, _ = footer.ToModel(m.Update(nil))
+					m, _ = footer.ToModel(m.Update(tea.KeyMsg{Type: tea.KeyUp}))
+					m, _ = footer.ToModel(m.Update(tea.KeyMsg{Type: tea.KeyUp}))
+					m, _ = footer.ToModel(m.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+					m, _ = footer.ToModel(uitest.SendString(m, "0"), nil)
+					m.Blur()
+					m, _ = footer.ToModel(m.Update(nil))
+					return m
+				},
+			},
+			want: want{
+				model: func(m footer.Model) {
+					ts := []repository.Trailer{
+						{Key: "Refs", Value: "#10"},
+						{Key: "Fixes", Value: "#2"},
+					}
+
+					assert.Equal(t, ts, m.Value())
+				},
+			},
+		},
+		{
+			name: "edit_unchanged",
+			args: args{
+				trailers: []repository.Trailer{
+					{Key: "Refs", Value: "#1"},
+					{Key: "Fixes", Value: "#2"},
+				},
+				model: func(m footer.Model) footer.Model {
+					m.Focus()
+					m, _ = footer.ToModel(m.Update(nil))
+					m, _ = footer.ToModel(m.Update(tea.KeyMsg{Type: tea.KeyUp}))
+					m, _ = footer.ToModel(m.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+					return m
+				},
+			},
+			want: want{
+				model: func(m footer.Model) {
+					ts := []repository.Trailer{
+						{Key: "Refs", Value: "#1"},
+						{Key: "Fixes", Value: "#2"},
+					}
+
+					assert.Equal(t, ts, m.Value())
+				},
+			},
+		},
+		{
+			name: "edit_again",
+			args: args{
+				trailers: []repository.Trailer{
+					{Key: "Refs", Value: "#1"},
+					{Key: "Fixes", Value: "#2"},
+				},
+				model: func(m footer.Model) footer.Model {
+					m.Focus()
+					m, _ = footer.ToModel(m.Update(nil))
+					m, _ = footer.ToModel(m.Update(tea.KeyMsg{Type: tea.KeyUp}))
+					m, _ = footer.ToModel(m.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+					m, _ = footer.ToModel(uitest.SendString(m, "0"), nil)
+					m, _ = footer.ToModel(m.Update(tea.KeyMsg{Type: tea.KeyUp}))
+					m, _ = footer.ToModel(m.Update(tea.KeyMsg{Type: tea.KeyUp}))
+					m, _ = footer.ToModel(m.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+					m, _ = footer.ToModel(uitest.SendString(m, "1"), nil)
+					m, _ = footer.ToModel(m.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+					return m
+				},
+			},
+			want: want{
+				model: func(m footer.Model) {
+					ts := []repository.Trailer{
+						{Key: "Refs", Value: "#11"},
+						{Key: "Fixes", Value: "#20"},
+					}
+
+					assert.Equal(t, ts, m.Value())
+				},
+			},
+		},
+		{
+			name: "remove",
+			args: args{
+				trailers: []repository.Trailer{
+					{Key: "Refs", Value: "#1"},
+					{Key: "Fixes", Value: "#2"},
+				},
+				model: func(m footer.Model) footer.Model {
+					m.Focus()
+					m, _ = footer.ToModel(m.Update(nil))
+					m, _ = footer.ToModel(m.Update(tea.KeyMsg{Type: tea.KeyUp}))
+					m, _ = footer.ToModel(m.Update(tea.KeyMsg{Type: tea.KeyDelete}))
+					return m
+				},
+			},
+			want: want{
+				model: func(m footer.Model) {
+					ts := []repository.Trailer{
+						{Key: "Refs", Value: "#1"},
+					}
+
+					assert.Equal(t, ts, m.Value())
+				},
+			},
+		},
+		{
+			name: "trailers_signoff",
+			args: args{
+				author: repository.User{
+					Name:  "John Doe",
+					Email: "john.doe@example.com",
+				},
+				trailers: []repository.Trailer{
+					{Key: "Fixes", Value: "#2"},
+				},
+				model: func(m footer.Model) footer.Model {
+					m.ToggleSignoff()
+					m, _ = footer.ToModel(m.Update(nil))
+					return m
+				},
+			},
+			want: want{
+				model: func(m footer.Model) {
+					ts := []repository.Trailer{
+						{Key: "Fixes", Value: "#2"},
+						signoff,
+					}
+
+					assert.Equal(t, ts, m.Value())
+					assert.Equal(t, 3, m.Height())
+				},
+			},
+		},
+		{
+			name: "trailers_signoff_duplicate",
+			args: args{
+				author: repository.User{
+					Name:  "John Doe",
+					Email: "john.doe@example.com",
+				},
+				trailers: []repository.Trailer{
+					signoff,
+				},
+				model: func(m footer.Model) footer.Model {
+					m.ToggleSignoff()
+					m, _ = footer.ToModel(m.Update(nil))
+					return m
+				},
+			},
+			want: want{
+				model: func(m footer.Model) {
+					assert.Equal(t, []repository.Trailer{signoff}, m.Value())
+				},
+			},
+		},
+		{
+			name: "picker",
+			args: args{
+				author: repository.User{
+					Name:  "John Doe",
+					Email: "john.doe@example.com",
+				},
+				authors: coAuthors,
+				model: func(m footer.Model) footer.Model {
+					m.Focus()
+					m, _ = footer.ToModel(m.Update(nil))
+					m, _ = footer.ToModel(m.Update(tea.KeyMsg{Type: tea.KeyCtrlA}))
+					return m
+				},
+			},
+			want: want{
+				model: func(m footer.Model) {
+					assert.Equal(t, 13, m.Height())
+				},
+			},
+		},
+		{
+			name: "picker_select",
+			args: args{
+				authors: coAuthors,
+				model: func(m footer.Model) footer.Model {
+					m.Focus()
+					m, _ = footer.ToModel(m.Update(nil))
+					m, _ = footer.ToModel(m.Update(tea.KeyMsg{Type: tea.KeyCtrlA}))
+					m, _ = footer.ToModel(m.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+					m, _ = footer.ToModel(m.Update(tea.KeyMsg{Type: tea.KeyDown}))
+					m, _ = footer.ToModel(m.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+					return m
+				},
+			},
+			want: want{
+				model: func(m footer.Model) {
+					ts := []repository.Trailer{
+						{Key: "Co-authored-by", Value: "Jane Doe <jane.doe@example.com>"},
+						{Key: "Co-authored-by", Value: "Bob Smith <bob.smith@example.com>"},
+					}
+
+					assert.Equal(t, ts, m.Value())
+				},
+			},
+		},
+		{
+			name: "picker_deselect",
+			args: args{
+				authors: coAuthors,
+				trailers: []repository.Trailer{
+					{Key: "Co-authored-by", Value: "Jane Doe <jane.doe@example.com>"},
+				},
+				model: func(m footer.Model) footer.Model {
+					m.Focus()
+					m, _ = footer.ToModel(m.Update(nil))
+					m, _ = footer.ToModel(m.Update(tea.KeyMsg{Type: tea.KeyCtrlA}))
+					m, _ = footer.ToModel(m.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+					return m
+				},
+			},
+			want: want{
+				model: func(m footer.Model) {
+					assert.Empty(t, m.Value())
+				},
+			},
+		},
+		{
+			name: "picker_filter",
+			args: args{
+				authors: coAuthors,
+				model: func(m footer.Model) footer.Model {
+					m.Focus()
+					m, _ = footer.ToModel(m.Update(nil))
+					m, _ = footer.ToModel(m.Update(tea.KeyMsg{Type: tea.KeyCtrlA}))
+					m, _ = footer.ToModel(uitest.SendString(m, "smith"), nil)
+					m, _ = footer.ToModel(m.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+					return m
+				},
+			},
+			want: want{
+				model: func(m footer.Model) {
+					ts := []repository.Trailer{
+						{Key: "Co-authored-by", Value: "Bob Smith <bob.smith@example.com>"},
+					}
+
+					assert.Equal(t, ts, m.Value())
+				},
+			},
+		},
+		{
+			name: "picker_close",
+			args: args{
+				authors: coAuthors,
+				model: func(m footer.Model) footer.Model {
+					m.Focus()
+					m, _ = footer.ToModel(m.Update(nil))
+					m, _ = footer.ToModel(m.Update(tea.KeyMsg{Type: tea.KeyCtrlA}))
+					m, _ = footer.ToModel(m.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+					m, _ = footer.ToModel(m.Update(tea.KeyMsg{Type: tea.KeyCtrlA}))
+					return m
+				},
+			},
+			want: want{
+				model: func(m footer.Model) {
+					assert.Equal(t, 5, m.Height())
 				},
 			},
 		},
@@ -86,7 +506,7 @@ func TestModel(t *testing.T) {
 
 					assert.Equal(t, u, m.Author)
 					assert.Equal(t, false, m.Signoff)
-					assert.Equal(t, "", m.Value())
+					assert.Empty(t, m.Value())
 				},
 			},
 		},
@@ -105,7 +525,10 @@ func TestModel(t *testing.T) {
 				state.Repository.Users = []repository.User{tt.args.author}
 			}
 
+			state.Repository.Authors = tt.args.authors
+
 			m := footer.New(state)
+			m.SetTrailers(tt.args.trailers)
 
 			if tt.args.model != nil {
 				m = tt.args.model(m)
@@ -0,0 +1,77 @@
+package footer
+
+import (
+	"github.com/mikelorant/committed/internal/theme"
+	"github.com/mikelorant/committed/internal/ui/colour"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+type Styles struct {
+	view                  lipgloss.Style
+	focusBoundary         lipgloss.Style
+	picker                lipgloss.Style
+	key                   lipgloss.Style
+	selected              lipgloss.Style
+	unselected            lipgloss.Style
+	inputPromptStyle      lipgloss.Style
+	inputTextStyle        lipgloss.Style
+	inputPlaceholderStyle lipgloss.Style
+	inputCursorStyle      lipgloss.Style
+}
+
+const selectedMark = ">"
+
+func defaultStyles(th theme.Theme) Styles {
+	var s Styles
+
+	clr := colour.New(th).Footer()
+
+	s.view = lipgloss.NewStyle().
+		Width(74).
+		MarginLeft(4).
+		MarginBottom(1).
+		Align(lipgloss.Left, lipgloss.Center).
+		Border(lipgloss.HiddenBorder(), false, true).
+		Padding(0, 1, 0, 1).
+		Foreground(clr.View)
+
+	s.focusBoundary = lipgloss.NewStyle().
+		Width(74).
+		MarginLeft(4).
+		MarginBottom(1).
+		Align(lipgloss.Left, lipgloss.Top).
+		BorderStyle(lipgloss.NormalBorder()).
+		BorderForeground(clr.FocusBoundary).
+		Padding(0, 1, 0, 1).
+		Foreground(clr.View)
+
+	s.picker = lipgloss.NewStyle().
+		MarginBottom(1)
+
+	s.key = lipgloss.NewStyle().
+		Foreground(clr.Key)
+
+	s.selected = lipgloss.NewStyle().
+		Foreground(clr.Selected).
+		MarginRight(1).
+		SetString(selectedMark)
+
+	s.unselected = lipgloss.NewStyle().
+		MarginRight(1).
+		SetString(" ")
+
+	s.inputPromptStyle = lipgloss.NewStyle().
+		Foreground(clr.InputPromptStyle)
+
+	s.inputTextStyle = lipgloss.NewStyle().
+		Foreground(clr.InputTextStyle)
+
+	s.inputPlaceholderStyle = lipgloss.NewStyle().
+		Foreground(clr.InputPlaceholderStyle)
+
+	s.inputCursorStyle = lipgloss.NewStyle().
+		Foreground(clr.InputCursorStyle)
+
+	return s
+}
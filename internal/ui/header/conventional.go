@@ -0,0 +1,119 @@
+package header
+
+import (
+	"fmt"
+
+	"github.com/mikelorant/committed/internal/commit"
+	"github.com/mikelorant/committed/internal/fuzzy"
+
+	"github.com/charmbracelet/bubbles/list"
+)
+
+type typeListItem struct {
+	name        string
+	description string
+}
+
+type typeFuzzyItem struct {
+	name        string
+	description string
+}
+
+type scopeListItem struct {
+	name string
+}
+
+type scopeFuzzyItem struct {
+	name string
+}
+
+func (i typeListItem) Title() string {
+	if i.description == "" {
+		return i.name
+	}
+
+	return fmt.Sprintf("%-8s - %s", i.name, i.description)
+}
+
+func (i typeListItem) Description() string {
+	return i.description
+}
+
+func (i typeListItem) FilterValue() string {
+	return i.name
+}
+
+func (i typeFuzzyItem) Fields() []fuzzy.Field {
+	return []fuzzy.Field{
+		{Value: i.name, Weight: fuzzy.WeightHigh},
+		{Value: i.description, Weight: fuzzy.WeightLow},
+	}
+}
+
+func (i scopeListItem) Title() string {
+	return i.name
+}
+
+func (i scopeListItem) Description() string {
+	return i.name
+}
+
+func (i scopeListItem) FilterValue() string {
+	return i.name
+}
+
+func (i scopeFuzzyItem) Fields() []fuzzy.Field {
+	return []fuzzy.Field{
+		{Value: i.name, Weight: fuzzy.WeightHigh},
+	}
+}
+
+func types(state *commit.State) []string {
+	return commit.Types(state.Config.Commit)
+}
+
+func castToTypeListItems(types []string) []list.Item {
+	res := make([]list.Item, len(types))
+	for i, t := range types {
+		res[i] = typeListItem{
+			name:        t,
+			description: commit.TypeDescriptions[t],
+		}
+	}
+
+	return res
+}
+
+func castToTypeFuzzyItems(types []string) []fuzzy.Item {
+	res := make([]fuzzy.Item, len(types))
+	for i, t := range types {
+		res[i] = typeFuzzyItem{
+			name:        t,
+			description: commit.TypeDescriptions[t],
+		}
+	}
+
+	return res
+}
+
+func castToScopeListItems(scopes []string) []list.Item {
+	res := make([]list.Item, len(scopes))
+	for i, s := range scopes {
+		res[i] = scopeListItem{
+			name: s,
+		}
+	}
+
+	return res
+}
+
+func castToScopeFuzzyItems(scopes []string) []fuzzy.Item {
+	res := make([]fuzzy.Item, len(scopes))
+	for i, s := range scopes {
+		res[i] = scopeFuzzyItem{
+			name: s,
+		}
+	}
+
+	return res
+}
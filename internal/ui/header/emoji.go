@@ -6,6 +6,7 @@ import (
 	"github.com/mikelorant/committed/internal/config"
 	"github.com/mikelorant/committed/internal/emoji"
 	"github.com/mikelorant/committed/internal/fuzzy"
+	"github.com/mikelorant/committed/internal/usage"
 
 	"github.com/charmbracelet/bubbles/list"
 	"github.com/mattn/go-runewidth"
@@ -14,8 +15,11 @@ import (
 type listItem struct {
 	emoji         emoji.Emoji
 	compatibility config.Compatibility
+	favourite     bool
 }
 
+const favouriteMarker = "★"
+
 type fuzzyItem struct {
 	emoji emoji.Emoji
 }
@@ -36,7 +40,12 @@ func (i listItem) Title() string {
 		}
 	}
 
-	return fmt.Sprintf("%s%s - %s", i.emoji.Character, space, i.emoji.Description)
+	var pin string
+	if i.favourite {
+		pin = " " + favouriteMarker
+	}
+
+	return fmt.Sprintf("%s%s - %s%s", i.emoji.Character, space, i.emoji.Description, pin)
 }
 
 func (i listItem) Description() string {
@@ -47,10 +56,18 @@ func (i listItem) FilterValue() string {
 	return i.emoji.Name
 }
 
-func (i fuzzyItem) Terms() []string {
-	return []string{
-		i.emoji.Description,
+func (i fuzzyItem) Fields() []fuzzy.Field {
+	fs := []fuzzy.Field{
+		{Value: i.emoji.Shortcode, Weight: fuzzy.WeightHigh},
+		{Value: i.emoji.Name, Weight: fuzzy.WeightHigh},
+		{Value: i.emoji.Description, Weight: fuzzy.WeightLow},
+	}
+
+	for _, k := range i.emoji.Keywords {
+		fs = append(fs, fuzzy.Field{Value: k, Weight: fuzzy.WeightMedium})
 	}
+
+	return fs
 }
 
 func WithCompatibility(c config.Compatibility) func(*listItem) {
@@ -59,6 +76,13 @@ func WithCompatibility(c config.Compatibility) func(*listItem) {
 	}
 }
 
+// WithFavourites marks the pinned emojis.
+func WithFavourites(favourites []string) func(*listItem) {
+	return func(i *listItem) {
+		i.favourite = usage.Favourite(favourites, i.emoji.Shortcode)
+	}
+}
+
 func castToListItems(emojis []emoji.Emoji, opts ...func(*listItem)) []list.Item {
 	res := make([]list.Item, len(emojis))
 	for i, e := range emojis {
@@ -7,8 +7,12 @@ import (
 	"github.com/mikelorant/committed/internal/config"
 	"github.com/mikelorant/committed/internal/emoji"
 	"github.com/mikelorant/committed/internal/fuzzy"
+	"github.com/mikelorant/committed/internal/keymap"
+	"github.com/mikelorant/committed/internal/lint"
 	"github.com/mikelorant/committed/internal/ui/colour"
 	"github.com/mikelorant/committed/internal/ui/filterlist"
+	"github.com/mikelorant/committed/internal/usage"
+	"github.com/mikelorant/committed/internal/vim"
 
 	"github.com/charmbracelet/bubbles/list"
 	"github.com/charmbracelet/bubbles/textinput"
@@ -23,7 +27,10 @@ type Model struct {
 	Placeholder   string
 	Emoji         emoji.Emoji
 	Emojis        []emoji.Emoji
+	Favourites    []string
+	Conventional  commit.Conventional
 	Amend         bool
+	Diagnostics   []lint.Result
 
 	focus     bool
 	component component
@@ -32,7 +39,17 @@ type Model struct {
 	height    int
 
 	summaryInput textinput.Model
+	modal        bool
+	vim          vim.Editor
 	filterList   filterlist.Model
+	typeList     filterlist.Model
+	scopeList    filterlist.Model
+	squashList   filterlist.Model
+
+	emojiIndex  *fuzzy.Index
+	typeIndex   *fuzzy.Index
+	scopeIndex  *fuzzy.Index
+	squashIndex *fuzzy.Index
 }
 
 type component int
@@ -40,8 +57,13 @@ type component int
 const (
 	emojiComponent component = iota
 	summaryComponent
+	typeComponent
+	scopeComponent
+	squashComponent
 
 	subjectLimit = 50
+	summaryWidth = 50
+	prefixWidth  = 52
 
 	defaultHeight = 3
 	expandHeight  = 16
@@ -49,22 +71,52 @@ const (
 
 	filterHeight     = 9
 	filterPromptText = "Choose an emoji:"
+	typePromptText   = "Choose a type:"
+	scopePromptText  = "Choose or enter a scope:"
+	squashPromptText = "Choose a squashed commit:"
 )
 
 func New(state *commit.State) Model {
+	emojis := usage.Pin(state.Emojis.Emojis, state.Favourites)
+
 	m := Model{
 		DefaultHeight: defaultHeight,
 		ExpandHeight:  expandHeight,
-		Emojis:        state.Emojis.Emojis,
+		Emojis:        emojis,
+		Favourites:    state.Favourites,
 		state:         state,
 		styles:        defaultStyles(state.Theme),
 		summaryInput:  summaryInput(state),
+		modal:         state.Config.View.EditMode == config.EditModeVim,
+		vim:           vim.New(true),
+		emojiIndex:    fuzzy.NewIndex(castToFuzzyItems(emojis)),
+		typeIndex:     fuzzy.NewIndex(castToTypeFuzzyItems(types(state))),
+		scopeIndex:    fuzzy.NewIndex(castToScopeFuzzyItems(state.Config.Commit.Scopes)),
+		squashIndex:   fuzzy.NewIndex(castToSquashFuzzyItems(state.File.Squash)),
 		filterList: filterlist.New(
-			castToListItems(state.Emojis.Emojis, WithCompatibility(state.Config.View.Compatibility)),
+			castToListItems(emojis, WithCompatibility(state.Config.View.Compatibility), WithFavourites(state.Favourites)),
 			filterPromptText,
 			filterHeight,
 			state,
 		),
+		typeList: filterlist.New(
+			castToTypeListItems(types(state)),
+			typePromptText,
+			filterHeight,
+			state,
+		),
+		scopeList: filterlist.New(
+			castToScopeListItems(state.Config.Commit.Scopes),
+			scopePromptText,
+			filterHeight,
+			state,
+		),
+		squashList: filterlist.New(
+			castToSquashListItems(state.File.Squash),
+			squashPromptText,
+			filterHeight,
+			state,
+		),
 	}
 
 	return m
@@ -79,18 +131,86 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	var cmd tea.Cmd
 	var cmds []tea.Cmd
 
+	if key, ok := msg.(tea.KeyMsg); ok && m.modal && m.component == summaryComponent && m.summaryInput.Focused() {
+		b := vim.NewBuffer(m.summaryInput.Value(), 0, m.summaryInput.Position())
+		if b, ok := m.vim.Update(key.String(), b); ok {
+			m.summaryInput.SetValue(b.String())
+			m.summaryInput.SetCursor(b.Col)
+			msg = nil
+		}
+	}
+
 	if m.component == emojiComponent {
 		//nolint:gocritic
 		switch msg := msg.(type) {
 		case tea.KeyMsg:
-			switch msg.String() {
-			case "enter":
+			key := msg.String()
+
+			switch {
+			case key == "enter":
 				if m.filterList.Focused() {
 					m.Emoji = m.filterList.SelectedItem().(listItem).emoji
 					return m, nil
 				}
-			case "delete":
+			case key == "delete":
 				m.Emoji = emoji.Emoji{}
+			case m.state.Keymap.Matches(key, keymap.PinEmoji):
+				if item, ok := m.filterList.SelectedItem().(listItem); ok {
+					m.Favourites = usage.Toggle(m.Favourites, item.emoji.Shortcode)
+					m.Emojis = usage.Pin(m.state.Emojis.Emojis, m.Favourites)
+					m.emojiIndex = fuzzy.NewIndex(castToFuzzyItems(m.Emojis))
+				}
+			}
+		}
+	}
+
+	if m.component == typeComponent {
+		//nolint:gocritic
+		switch msg := msg.(type) {
+		case tea.KeyMsg:
+			switch msg.String() {
+			case "enter":
+				if m.typeList.Focused() {
+					if item, ok := m.typeList.SelectedItem().(typeListItem); ok {
+						m.Conventional.Type = item.name
+					}
+					return m, nil
+				}
+			case "delete":
+				m.Conventional.Type = ""
+			}
+		}
+	}
+
+	if m.component == scopeComponent {
+		//nolint:gocritic
+		switch msg := msg.(type) {
+		case tea.KeyMsg:
+			switch msg.String() {
+			case "enter":
+				if m.scopeList.Focused() {
+					m.Conventional.Scope = m.scopeList.Filter()
+					if item, ok := m.scopeList.SelectedItem().(scopeListItem); ok {
+						m.Conventional.Scope = item.name
+					}
+					return m, nil
+				}
+			case "delete":
+				m.Conventional.Scope = ""
+			}
+		}
+	}
+
+	if m.component == squashComponent {
+		//nolint:gocritic
+		switch msg := msg.(type) {
+		case tea.KeyMsg:
+			//nolint:gocritic
+			switch msg.String() {
+			case "enter":
+				if m.squashList.Focused() {
+					return m, nil
+				}
 			}
 		}
 	}
@@ -107,16 +227,48 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.height = m.ExpandHeight
 	}
 
+	m.summaryInput.Width = m.summaryWidth()
+
 	switch {
 	case m.focus && m.component == summaryComponent && !m.summaryInput.Focused():
 		m.filterList.Blur()
+		m.typeList.Blur()
+		m.scopeList.Blur()
+		m.squashList.Blur()
 		cmd = m.summaryInput.Focus()
 		return m, cmd
 	case m.focus && m.component == emojiComponent && !m.filterList.Focused():
 		m.summaryInput.Blur()
+		m.typeList.Blur()
+		m.scopeList.Blur()
+		m.squashList.Blur()
 		m.filterList.Focus()
 		m.filterList, cmd = filterlist.ToModel(m.filterList.Update(msg))
 		return m, cmd
+	case m.focus && m.component == typeComponent && !m.typeList.Focused():
+		m.summaryInput.Blur()
+		m.filterList.Blur()
+		m.scopeList.Blur()
+		m.squashList.Blur()
+		m.typeList.Focus()
+		m.typeList, cmd = filterlist.ToModel(m.typeList.Update(msg))
+		return m, cmd
+	case m.focus && m.component == scopeComponent && !m.scopeList.Focused():
+		m.summaryInput.Blur()
+		m.filterList.Blur()
+		m.typeList.Blur()
+		m.squashList.Blur()
+		m.scopeList.Focus()
+		m.scopeList, cmd = filterlist.ToModel(m.scopeList.Update(msg))
+		return m, cmd
+	case m.focus && m.component == squashComponent && !m.squashList.Focused():
+		m.summaryInput.Blur()
+		m.filterList.Blur()
+		m.typeList.Blur()
+		m.scopeList.Blur()
+		m.squashList.Focus()
+		m.squashList, cmd = filterlist.ToModel(m.squashList.Update(msg))
+		return m, cmd
 
 	case !m.focus && m.summaryInput.Focused():
 		m.summaryInput.Blur()
@@ -124,16 +276,58 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case !m.focus && m.filterList.Focused():
 		m.filterList.Blur()
 		return m, nil
+	case !m.focus && m.typeList.Focused():
+		m.typeList.Blur()
+		return m, nil
+	case !m.focus && m.scopeList.Focused():
+		m.scopeList.Blur()
+		return m, nil
+	case !m.focus && m.squashList.Focused():
+		m.squashList.Blur()
+		return m, nil
 
 	case m.focus && m.component == emojiComponent:
-		ranks := fuzzy.Rank(m.filterList.Filter(), castToFuzzyItems(m.Emojis))
+		ranks := m.emojiIndex.Rank(m.filterList.Filter())
+
+		compat := WithCompatibility(m.state.Config.View.Compatibility)
+		favs := WithFavourites(m.Favourites)
+		emojis := castToListItems(m.Emojis, compat, favs)
 
 		items := make([]list.Item, len(ranks))
 		for i, rank := range ranks {
-			compat := WithCompatibility(m.state.Config.View.Compatibility)
-			items[i] = castToListItems(m.Emojis, compat)[rank]
+			items[i] = emojis[rank]
 		}
 		m.filterList.SetItems(items)
+
+	case m.focus && m.component == typeComponent:
+		ts := types(m.state)
+		ranks := m.typeIndex.Rank(m.typeList.Filter())
+
+		items := make([]list.Item, len(ranks))
+		for i, rank := range ranks {
+			items[i] = castToTypeListItems(ts)[rank]
+		}
+		m.typeList.SetItems(items)
+
+	case m.focus && m.component == scopeComponent:
+		ss := m.state.Config.Commit.Scopes
+		ranks := m.scopeIndex.Rank(m.scopeList.Filter())
+
+		items := make([]list.Item, len(ranks))
+		for i, rank := range ranks {
+			items[i] = castToScopeListItems(ss)[rank]
+		}
+		m.scopeList.SetItems(items)
+
+	case m.focus && m.component == squashComponent:
+		ss := m.state.File.Squash
+		ranks := m.squashIndex.Rank(m.squashList.Filter())
+
+		items := make([]list.Item, len(ranks))
+		for i, rank := range ranks {
+			items[i] = castToSquashListItems(ss)[rank]
+		}
+		m.squashList.SetItems(items)
 	}
 
 	m.summaryInput, cmd = m.summaryInput.Update(msg)
@@ -142,6 +336,15 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	m.filterList, cmd = filterlist.ToModel(m.filterList.Update(msg))
 	cmds = append(cmds, cmd)
 
+	m.typeList, cmd = filterlist.ToModel(m.typeList.Update(msg))
+	cmds = append(cmds, cmd)
+
+	m.scopeList, cmd = filterlist.ToModel(m.scopeList.Update(msg))
+	cmds = append(cmds, cmd)
+
+	m.squashList, cmd = filterlist.ToModel(m.squashList.Update(msg))
+	cmds = append(cmds, cmd)
+
 	return m, tea.Batch(cmds...)
 }
 
@@ -170,6 +373,33 @@ func (m *Model) SelectSummary() {
 	m.component = summaryComponent
 }
 
+func (m *Model) SelectType() {
+	m.component = typeComponent
+}
+
+func (m *Model) SelectScope() {
+	m.component = scopeComponent
+}
+
+func (m *Model) SelectSquash() {
+	m.component = squashComponent
+}
+
+// SelectedSquash returns the highlighted squashed subject, or true when all
+// subjects should be combined.
+func (m Model) SelectedSquash() (string, bool) {
+	item, ok := m.squashList.SelectedItem().(squashListItem)
+	if !ok {
+		return "", false
+	}
+
+	return item.subject, item.combine
+}
+
+func (m *Model) ToggleBreaking() {
+	m.Conventional.Breaking = !m.Conventional.Breaking
+}
+
 func (m Model) Summary() string {
 	return m.summaryInput.Value()
 }
@@ -182,6 +412,16 @@ func (m *Model) ResetSummary() {
 	m.summaryInput.Reset()
 }
 
+// SummaryMode returns the vim mode of the summary, or nothing when modal
+// editing is not used.
+func (m Model) SummaryMode() string {
+	if !m.modal {
+		return ""
+	}
+
+	return m.vim.Mode().String()
+}
+
 func (m *Model) CursorStartSummary() {
 	m.summaryInput.CursorStart()
 }
@@ -199,6 +439,15 @@ func (m Model) headerRow() string {
 	bottom := m.filterList.View()
 	spacer := m.styles.spacer.Render("")
 
+	switch m.component {
+	case typeComponent:
+		bottom = m.typeList.View()
+	case scopeComponent:
+		bottom = m.scopeList.View()
+	case squashComponent:
+		bottom = m.squashList.View()
+	}
+
 	if m.state.Config.View.EmojiSelector == config.EmojiSelectorAbove {
 		top, bottom = bottom, top
 	}
@@ -227,11 +476,34 @@ func (m Model) emoji() string {
 }
 
 func (m Model) summary() string {
+	summary := m.summaryInput.View()
+
+	if pfx := commit.ConventionalToPrefix(m.Conventional); pfx != "" {
+		p := m.styles.summaryPrefix.Render(pfx)
+		summary = lipgloss.NewStyle().
+			MaxWidth(prefixWidth).
+			Render(lipgloss.JoinHorizontal(lipgloss.Top, p, summary))
+	}
+
 	if (m.focus && m.component == summaryComponent) || !m.state.Config.View.HighlightActive {
-		return m.styles.summaryFocusBoundary.Render(m.summaryInput.View())
+		return m.styles.summaryFocusBoundary.Render(summary)
 	}
 
-	return m.styles.summaryBoundary.Render(m.summaryInput.View())
+	return m.styles.summaryBoundary.Render(summary)
+}
+
+func (m Model) summaryWidth() int {
+	pfx := commit.ConventionalToPrefix(m.Conventional)
+	if pfx == "" {
+		return summaryWidth
+	}
+
+	w := summaryWidth - lipgloss.Width(pfx) - 1
+	if w < 1 {
+		return 1
+	}
+
+	return w
 }
 
 func (m Model) counter() string {
@@ -240,6 +512,10 @@ func (m Model) counter() string {
 		i += 3
 	}
 
+	if pfx := commit.ConventionalToPrefix(m.Conventional); pfx != "" {
+		i += len(pfx) + 1
+	}
+
 	c := counterStyle(i, m.state.Theme).Render(fmt.Sprintf("%d", i))
 	d := m.styles.counterDivider
 	t := m.styles.counterLimit.Render(fmt.Sprintf("%d", subjectLimit))
@@ -259,6 +535,12 @@ func (m Model) ready() string {
 		return m.styles.readyError.String()
 	case len(m.Summary()) < 1:
 		return m.styles.readyIncomplete.String()
+	case m.state.Config.Commit.Style == config.StyleConventional && m.Conventional.Type == "":
+		return m.styles.readyIncomplete.String()
+	case lint.HasErrors(m.Diagnostics):
+		return m.styles.readyError.String()
+	case len(m.Diagnostics) > 0:
+		return m.styles.readyIncomplete.String()
 	}
 
 	return m.styles.readyOK.String()
@@ -281,7 +563,7 @@ func summaryInput(state *commit.State) textinput.Model {
 	ti.Prompt = ""
 	ti.Placeholder = state.Placeholders.Summary
 	ti.CharLimit = 72
-	ti.Width = 50
+	ti.Width = summaryWidth
 
 	styleSummaryInput(&ti, state)
 
@@ -230,7 +230,7 @@ func TestModel(t *testing.T) {
 					m.SelectEmoji()
 					m.Expand = true
 					m, _ = header.ToModel(m.Update(nil))
-					m, _ = header.ToModel(uitest.SendString(m, "test test test"), nil)
+					m, _ = header.ToModel(uitest.SendString(m, "zzz zzz zzz"), nil)
 					m, _ = header.ToModel(m.Update(nil))
 					return m
 				},
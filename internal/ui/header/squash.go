@@ -0,0 +1,79 @@
+package header
+
+import (
+	"github.com/mikelorant/committed/internal/fuzzy"
+
+	"github.com/charmbracelet/bubbles/list"
+)
+
+type squashListItem struct {
+	subject string
+	combine bool
+}
+
+type squashFuzzyItem struct {
+	subject string
+}
+
+const combineTitle = "Combine all subjects"
+
+func (i squashListItem) Title() string {
+	if i.combine {
+		return combineTitle
+	}
+
+	return i.subject
+}
+
+func (i squashListItem) Description() string {
+	return i.Title()
+}
+
+func (i squashListItem) FilterValue() string {
+	return i.Title()
+}
+
+func (i squashFuzzyItem) Fields() []fuzzy.Field {
+	return []fuzzy.Field{
+		{Value: i.subject, Weight: fuzzy.WeightHigh},
+	}
+}
+
+func squashItems(subjects []string) []squashListItem {
+	res := make([]squashListItem, len(subjects)+1)
+	for i, s := range subjects {
+		res[i] = squashListItem{
+			subject: s,
+		}
+	}
+
+	res[len(subjects)] = squashListItem{
+		combine: true,
+	}
+
+	return res
+}
+
+func castToSquashListItems(subjects []string) []list.Item {
+	items := squashItems(subjects)
+
+	res := make([]list.Item, len(items))
+	for i, item := range items {
+		res[i] = item
+	}
+
+	return res
+}
+
+func castToSquashFuzzyItems(subjects []string) []fuzzy.Item {
+	items := squashItems(subjects)
+
+	res := make([]fuzzy.Item, len(items))
+	for i, item := range items {
+		res[i] = squashFuzzyItem{
+			subject: item.Title(),
+		}
+	}
+
+	return res
+}
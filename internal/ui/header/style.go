@@ -12,6 +12,7 @@ type Styles struct {
 	emojiFocusBoundary           lipgloss.Style
 	summaryBoundary              lipgloss.Style
 	summaryFocusBoundary         lipgloss.Style
+	summaryPrefix                lipgloss.Style
 	counterDivider               lipgloss.Style
 	counterLimit                 lipgloss.Style
 	counterBoundary              lipgloss.Style
@@ -67,6 +68,10 @@ func defaultStyles(th theme.Theme) Styles {
 	s.summaryFocusBoundary = s.summaryBoundary.Copy().
 		BorderForeground(clr.SummaryFocusBoundary)
 
+	s.summaryPrefix = lipgloss.NewStyle().
+		Foreground(clr.SummaryPrefix).
+		MarginRight(1)
+
 	s.counterDivider = lipgloss.NewStyle().
 		Foreground(clr.CounterDivider).
 		SetString("/")
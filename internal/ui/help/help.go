@@ -76,7 +76,7 @@ func ToModel(m tea.Model, c tea.Cmd) (Model, tea.Cmd) {
 
 func newViewport(w, h int, state *commit.State) viewport.Model {
 	vp := viewport.New(w, h)
-	vp.SetContent(state.Placeholders.Help)
+	vp.SetContent(state.Keymap.Help())
 
 	styleViewport(&vp, state)
 
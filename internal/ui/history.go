@@ -0,0 +1,140 @@
+package ui
+
+import (
+	"reflect"
+	"time"
+
+	"github.com/mikelorant/committed/internal/keymap"
+	"github.com/mikelorant/committed/internal/repository"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// history is the undo and redo stacks of the form. Typing within a component
+// is coalesced so that a burst of keys is undone at once.
+type history struct {
+	undo []form
+	redo []form
+	last edit
+	at   time.Time
+}
+
+// form is the state of every component that can be undone, including the
+// saved state swapped when toggling amend.
+type form struct {
+	save         savedState
+	author       repository.User
+	signoff      bool
+	amend        bool
+	currentSave  savedState
+	previousSave savedState
+}
+
+// edit describes the message that changed the form.
+type edit struct {
+	focus  focus
+	typing bool
+	skip   bool
+}
+
+const (
+	historyLimit  = 100
+	burstInterval = time.Second
+)
+
+// record adds the form before a change to the undo stack unless the change
+// continues a burst of typing. Any new change clears the redo stack.
+func (h history) record(before, after form, e edit, t time.Time) history {
+	if e.skip || reflect.DeepEqual(before, after) {
+		return h
+	}
+
+	burst := e.typing && h.last == e && t.Sub(h.at) < burstInterval
+	if !burst || len(h.undo) == 0 {
+		h.undo = append(h.undo, before)
+	}
+
+	if n := len(h.undo) - historyLimit; n > 0 {
+		h.undo = h.undo[n:]
+	}
+
+	h.redo = nil
+	h.last, h.at = e, t
+
+	return h
+}
+
+func (m Model) form() form {
+	save := m.backupModel()
+	save.trailers = append([]repository.Trailer(nil), save.trailers...)
+
+	return form{
+		save:         save,
+		author:       m.models.info.Author,
+		signoff:      m.signoff,
+		amend:        m.amend,
+		currentSave:  m.currentSave,
+		previousSave: m.previousSave,
+	}
+}
+
+func (m *Model) setForm(f form) {
+	m.restoreModel(f.save)
+	m.models.info.Author = f.author
+	m.signoff = f.signoff
+	m.amend = f.amend
+	m.currentSave = f.currentSave
+	m.previousSave = f.previousSave
+}
+
+// edit returns how a message changes the form. Only keys, including those
+// loading a snapshot or toggling amend, and the return from the editor change
+// the form. Undo and redo are not recorded as they move within the history.
+func (m Model) edit(msg tea.Msg) edit {
+	var key tea.KeyMsg
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		key = msg
+	case editorMsg:
+		return edit{focus: m.focus}
+	default:
+		return edit{skip: true}
+	}
+
+	action, _ := m.state.Keymap.Action(key.String())
+
+	return edit{
+		focus:  m.focus,
+		typing: !key.Alt && (key.Type == tea.KeyRunes || key.Type == tea.KeySpace || key.Type == tea.KeyBackspace),
+		skip:   action == keymap.Undo || action == keymap.Redo,
+	}
+}
+
+func (m Model) undo() Model {
+	n := len(m.history.undo)
+	if n == 0 {
+		return m
+	}
+
+	m.history.redo = append(m.history.redo, m.form())
+	m.setForm(m.history.undo[n-1])
+	m.history.undo = m.history.undo[:n-1]
+	m.history.last = edit{}
+
+	return m
+}
+
+func (m Model) redo() Model {
+	n := len(m.history.redo)
+	if n == 0 {
+		return m
+	}
+
+	m.history.undo = append(m.history.undo, m.form())
+	m.setForm(m.history.redo[n-1])
+	m.history.redo = m.history.redo[:n-1]
+	m.history.last = edit{}
+
+	return m
+}
@@ -29,10 +29,10 @@ func (i listItem) FilterValue() string {
 	return i.author.Name
 }
 
-func (i fuzzyItem) Terms() []string {
-	return []string{
-		i.author.Name,
-		i.author.Email,
+func (i fuzzyItem) Fields() []fuzzy.Field {
+	return []fuzzy.Field{
+		{Value: i.author.Name, Weight: fuzzy.WeightHigh},
+		{Value: i.author.Email, Weight: fuzzy.WeightMedium},
 	}
 }
 
@@ -28,11 +28,13 @@ type Model struct {
 	Date          string
 	Author        repository.User
 	Authors       []repository.User
+	Conflicts     []string
 
 	focus      bool
 	state      *commit.State
 	styles     Styles
 	filterList filterlist.Model
+	index      *fuzzy.Index
 }
 
 const (
@@ -56,8 +58,10 @@ func New(state *commit.State) Model {
 		Date:         time.Now().Format(dateTimeFormat),
 		Author:       authors[0],
 		Authors:      authors,
+		Conflicts:    state.File.Conflicts,
 		state:        state,
 		styles:       defaultStyles(state.Theme),
+		index:        fuzzy.NewIndex(castToFuzzyItems(authors)),
 		filterList: filterlist.New(
 			castToListItems(state.Repository.Users),
 			filterPromptText,
@@ -107,11 +111,12 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.filterList.Focus()
 		fallthrough
 	case m.focus:
-		ranks := fuzzy.Rank(m.filterList.Filter(), castToFuzzyItems(m.Authors))
+		ranks := m.index.Rank(m.filterList.Filter())
+		authors := castToListItems(m.Authors)
 
 		items := make([]list.Item, len(ranks))
 		for i, rank := range ranks {
-			items[i] = castToListItems(m.Authors)[rank]
+			items[i] = authors[rank]
 		}
 		m.filterList.SetItems(items)
 	}
@@ -145,12 +150,17 @@ func (m Model) infoColumn() string {
 		m.branchRefs(),
 	)
 
-	it := lipgloss.JoinVertical(
-		lipgloss.Top,
+	rows := []string{
 		hashBranchRefs,
 		m.author(),
 		m.date(),
-	)
+	}
+
+	if len(m.Conflicts) > 0 {
+		rows = append(rows, m.conflicts())
+	}
+
+	it := lipgloss.JoinVertical(lipgloss.Top, rows...)
 
 	if !m.Expand {
 		return it
@@ -220,6 +230,14 @@ func (m Model) date() string {
 	return fmt.Sprintf("%s%s   %s", k, c, d)
 }
 
+func (m Model) conflicts() string {
+	k := m.styles.conflictsText
+	c := m.styles.colon
+	v := m.styles.conflictsValue.Render(strings.Join(m.Conflicts, ", "))
+
+	return fmt.Sprintf("%s%s %s", k, c, v)
+}
+
 func ToModel(m tea.Model, c tea.Cmd) (Model, tea.Cmd) {
 	return m.(Model), c
 }
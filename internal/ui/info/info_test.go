@@ -106,6 +106,14 @@ func TestModel(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "conflicts",
+			args: args{
+				state: func(c *commit.State) {
+					c.File.Conflicts = []string{"a.go", "b/c.go"}
+				},
+			},
+		},
 		{
 			name: "no_users",
 			args: args{
@@ -34,6 +34,9 @@ type Styles struct {
 
 	dateText  lipgloss.Style
 	dateValue lipgloss.Style
+
+	conflictsText  lipgloss.Style
+	conflictsValue lipgloss.Style
 }
 
 func defaultStyles(th theme.Theme) Styles {
@@ -98,5 +101,12 @@ func defaultStyles(th theme.Theme) Styles {
 	s.dateValue = lipgloss.NewStyle().
 		Foreground(clr.DateValue)
 
+	s.conflictsText = lipgloss.NewStyle().
+		Foreground(clr.ConflictsText).
+		SetString("conflicts")
+
+	s.conflictsValue = lipgloss.NewStyle().
+		Foreground(clr.ConflictsValue)
+
 	return s
 }
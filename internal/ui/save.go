@@ -1,10 +1,18 @@
 package ui
 
+import (
+	"fmt"
+
+	"github.com/mikelorant/committed/internal/commit"
+)
+
 func (m *Model) restoreModel(save savedState) {
 	m.models.header.Amend = save.amend
 	m.models.header.Emoji = save.emoji
+	m.models.header.Conventional = save.conventional
 	m.models.header.SetSummary(save.summary)
 	m.models.body.SetValue(save.body)
+	m.models.footer.SetTrailers(save.trailers)
 }
 
 func (m *Model) backupModel() savedState {
@@ -13,7 +21,9 @@ func (m *Model) backupModel() savedState {
 	save.amend = m.models.header.Amend
 	save.emoji = m.models.header.Emoji
 	save.summary = m.models.header.Summary()
+	save.conventional = m.models.header.Conventional
 	save.body = m.models.body.RawValue()
+	save.trailers = m.models.footer.Trailers
 
 	return save
 }
@@ -48,7 +58,7 @@ func (m *Model) setSaves() {
 func (m *Model) setSave() bool {
 	save := m.snapshotToSave()
 
-	hasSave := (save.body != "" || save.emoji.Name != "" || save.summary != "")
+	hasSave := (save.body != "" || save.emoji.Name != "" || save.summary != "" || save.conventional.Type != "" || len(save.trailers) > 0)
 
 	switch {
 	case m.currentSave.amend && save.amend:
@@ -75,6 +85,7 @@ func (m *Model) swapSave() {
 
 	m.models.header.ResetSummary()
 	m.models.body.Reset()
+	m.models.footer.Reset()
 
 	m.currentSave, m.previousSave = m.previousSave, m.currentSave
 
@@ -84,15 +95,53 @@ func (m *Model) swapSave() {
 func (m *Model) loadSave(st savedState) {
 	m.models.header.ResetSummary()
 	m.models.body.Reset()
+	m.models.footer.Reset()
 
 	m.restoreModel(st)
 }
 
+func (m *Model) pickSquash() {
+	subject, combine := m.models.header.SelectedSquash()
+
+	// Combined subjects are listed above the bodies with the first subject
+	// as the summary.
+	if combine && len(m.state.File.Squash) > 0 {
+		body := commit.CombineSubjects(m.state.File.Squash)
+		if b := commit.SquashToBody(m.state.File.Message); b != "" {
+			body = fmt.Sprintf("%s\n\n%s", body, b)
+		}
+
+		m.models.body.SetValue(body)
+		subject = m.state.File.Squash[0]
+	}
+
+	if subject == "" {
+		return
+	}
+
+	if e := commit.MessageToEmoji(m.state.Emojis, subject, m.state.Config.Commit); e.Valid {
+		m.models.header.Emoji = e.Emoji
+	}
+
+	if conv := messageToConventional(m.state, subject); conv.Type != "" {
+		m.models.header.Conventional = conv
+	}
+
+	m.models.header.SetSummary(commit.MessageToSummary(subject, m.state.Config.Commit))
+	m.models.header.CursorStartSummary()
+}
+
 func (m Model) snapshotToSave() savedState {
 	s := savedState{
 		amend:   m.state.Snapshot.Amend,
 		summary: m.state.Snapshot.Summary,
-		body:    m.state.Snapshot.Body,
+		conventional: commit.Conventional{
+			Type:     m.state.Snapshot.Type,
+			Scope:    m.state.Snapshot.Scope,
+			Breaking: m.state.Snapshot.Breaking,
+		},
+		body:     m.state.Snapshot.Body,
+		trailers: m.state.Snapshot.Trailers,
 	}
 
 	if e := m.state.Emojis.Find(m.state.Snapshot.Emoji); e.Valid {
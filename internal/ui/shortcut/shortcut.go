@@ -28,6 +28,7 @@ const (
 	AltModifier
 	ControlModifier
 	ShiftModifier
+	OtherModifier
 
 	AlignLeft = iota
 	AlignRight
@@ -1,24 +1,34 @@
 package status
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/mikelorant/committed/internal/commit"
+	"github.com/mikelorant/committed/internal/config"
+	"github.com/mikelorant/committed/internal/keymap"
+	"github.com/mikelorant/committed/internal/lint"
+	"github.com/mikelorant/committed/internal/ui/colour"
 	"github.com/mikelorant/committed/internal/ui/shortcut"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
 )
 
 type Model struct {
-	Shortcuts shortcut.Shortcuts
-	shortcut  shortcut.Model
-	state     *commit.State
+	Shortcuts   shortcut.Shortcuts
+	Diagnostics []lint.Result
+	Mode        string
+	Err         error
+	shortcut    shortcut.Model
+	state       *commit.State
+	styles      Styles
 }
 
 func New(state *commit.State) Model {
 	ds := shortcut.Shortcuts{
-		Modifiers:   defaultModifiers(),
-		KeyBindings: defaultKeyBindings(),
+		Modifiers:   keyModifiers(state.Keymap),
+		KeyBindings: keyBindings(state.Keymap),
 		State:       state,
 	}
 
@@ -26,6 +36,7 @@ func New(state *commit.State) Model {
 		Shortcuts: ds,
 		shortcut:  shortcut.New(ds),
 		state:     state,
+		styles:    defaultStyles(state.Theme),
 	}
 }
 
@@ -35,6 +46,12 @@ func (m Model) Init() tea.Cmd {
 
 //nolint:ireturn
 func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	//nolint:gocritic
+	switch msg.(type) {
+	case colour.Msg:
+		m.styles = defaultStyles(m.state.Theme)
+	}
+
 	m.Shortcuts.State = m.state
 	m.shortcut.Shortcuts = m.Shortcuts
 	m.shortcut, _ = shortcut.ToModel(m.shortcut.Update(nil))
@@ -43,12 +60,65 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 }
 
 func (m Model) View() string {
-	return m.shortcut.View()
+	if len(m.Diagnostics) == 0 && m.Mode == "" && m.Err == nil {
+		return m.shortcut.View()
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Top,
+		m.indicator(),
+		m.shortcut.View(),
+	)
+}
+
+// indicator returns the line above the shortcuts with the edit mode followed
+// by any error or else the first diagnostic.
+func (m Model) indicator() string {
+	var line []string
+
+	if m.Mode != "" {
+		line = append(line, m.styles.mode.Render(fmt.Sprintf("-- %s --", m.Mode)))
+	}
+
+	switch {
+	case m.Err != nil:
+		line = append(line, m.failure())
+	case len(m.Diagnostics) > 0:
+		line = append(line, m.diagnostic())
+	}
+
+	return lipgloss.JoinHorizontal(lipgloss.Top, line...)
+}
+
+func (m Model) diagnostic() string {
+	d := m.Diagnostics[0]
+
+	mark := m.styles.diagnosticWarning.String()
+	if d.Severity == config.SeverityError {
+		mark = m.styles.diagnosticError.String()
+	}
+
+	text := d.Message
+	if n := len(m.Diagnostics) - 1; n > 0 {
+		text = fmt.Sprintf("%s (+%d more)", text, n)
+	}
+
+	return m.styles.diagnostic.Render(
+		lipgloss.JoinHorizontal(lipgloss.Top, mark, m.styles.diagnosticText.Render(text)),
+	)
 }
 
-func GlobalShortcuts(next, previous string) shortcut.Shortcuts {
-	mods := defaultModifiers()
-	kb := defaultKeyBindings()
+func (m Model) failure() string {
+	return m.styles.diagnostic.Render(
+		lipgloss.JoinHorizontal(lipgloss.Top,
+			m.styles.diagnosticError.String(),
+			m.styles.diagnosticText.Render(m.Err.Error()),
+		),
+	)
+}
+
+func GlobalShortcuts(km keymap.Keymap, next, previous string) shortcut.Shortcuts {
+	mods := keyModifiers(km)
+	kb := keyBindings(km)
 
 	switch next {
 	case "":
@@ -68,13 +138,15 @@ func GlobalShortcuts(next, previous string) shortcut.Shortcuts {
 
 		kb = append(kb, shortcut.KeyBinding{
 			Modifier: shortcut.NoModifier,
-			Key:      "tab",
+			Key:      km.Key(keymap.Next),
 			Label:    next,
 		})
 	}
 
-	switch previous {
-	case "":
+	mod, key := splitKey(km.Key(keymap.Previous))
+
+	switch {
+	case previous == "":
 		mods = append(mods, shortcut.Modifier{
 			Modifier: shortcut.ShiftModifier,
 			Align:    shortcut.AlignRight,
@@ -84,7 +156,7 @@ func GlobalShortcuts(next, previous string) shortcut.Shortcuts {
 		kb = append(kb, shortcut.KeyBinding{
 			Modifier: shortcut.ShiftModifier,
 		})
-	default:
+	case mod == shortcut.ShiftModifier:
 		mods = append(mods, shortcut.Modifier{
 			Modifier: shortcut.ShiftModifier,
 			Label:    "Shift", Align: shortcut.AlignRight,
@@ -92,7 +164,19 @@ func GlobalShortcuts(next, previous string) shortcut.Shortcuts {
 
 		kb = append(kb, shortcut.KeyBinding{
 			Modifier: shortcut.ShiftModifier,
-			Key:      "tab",
+			Key:      key,
+			Label:    previous,
+		})
+	default:
+		// The row keeps its place when the key is not shifted.
+		mods = append(mods, shortcut.Modifier{
+			Modifier: shortcut.ShiftModifier,
+			Align:    shortcut.AlignRight,
+		})
+
+		kb = append(kb, shortcut.KeyBinding{
+			Modifier: shortcut.ShiftModifier,
+			Key:      km.Key(keymap.Previous),
 			Label:    previous,
 		})
 	}
@@ -103,9 +187,9 @@ func GlobalShortcuts(next, previous string) shortcut.Shortcuts {
 	}
 }
 
-func HelpShortcuts() shortcut.Shortcuts {
-	kb := defaultKeyBindings()
-	mods := defaultModifiers()
+func HelpShortcuts(km keymap.Keymap) shortcut.Shortcuts {
+	kb := keyBindings(km)
+	mods := keyModifiers(km)
 
 	mods = append(mods, shortcut.Modifier{
 		Modifier: shortcut.NoModifier,
@@ -128,20 +212,60 @@ func ToModel(m tea.Model, c tea.Cmd) (Model, tea.Cmd) {
 	return m.(Model), c
 }
 
-func defaultModifiers() []shortcut.Modifier {
-	return []shortcut.Modifier{
+// keyModifiers returns the alt and control rows, and a row for the keys
+// bound without either.
+func keyModifiers(km keymap.Keymap) []shortcut.Modifier {
+	mods := []shortcut.Modifier{
 		{Modifier: shortcut.AltModifier, Label: "Alt", Align: shortcut.AlignLeft},
 		{Modifier: shortcut.ControlModifier, Label: "Ctrl", Align: shortcut.AlignLeft},
 	}
+
+	for _, kb := range keyBindings(km) {
+		if kb.Modifier == shortcut.OtherModifier {
+			mods = append(mods, shortcut.Modifier{Modifier: shortcut.OtherModifier, Align: shortcut.AlignLeft})
+
+			break
+		}
+	}
+
+	return mods
 }
 
-func defaultKeyBindings() []shortcut.KeyBinding {
-	return []shortcut.KeyBinding{
-		{Modifier: shortcut.ControlModifier, Key: "c", Label: "Cancel"},
-		{Modifier: shortcut.ControlModifier, Key: "h", Label: "Help"},
-		{Modifier: shortcut.AltModifier, Key: "enter", Label: "Commit"},
-		{Modifier: shortcut.AltModifier, Key: "a", Label: "Amend"},
-		{Modifier: shortcut.AltModifier, Key: "l", Label: "Load"},
-		{Modifier: shortcut.AltModifier, Key: "s", Label: "Sign-off"},
+// keyBindings returns the global bindings shown as shortcuts.
+func keyBindings(km keymap.Keymap) []shortcut.KeyBinding {
+	var kb []shortcut.KeyBinding
+
+	for _, b := range km.Bindings(keymap.GroupGlobal) {
+		if b.Shortcut == "" {
+			continue
+		}
+
+		mod, key := splitKey(b.Key())
+		if mod != shortcut.AltModifier && mod != shortcut.ControlModifier {
+			mod, key = shortcut.OtherModifier, b.Key()
+		}
+
+		kb = append(kb, shortcut.KeyBinding{Modifier: mod, Key: key, Label: b.Shortcut})
 	}
+
+	return kb
+}
+
+// splitKey returns the modifier of a key and the key without it.
+func splitKey(key string) (int, string) {
+	mod, k, ok := strings.Cut(key, "+")
+	if !ok || k == "" {
+		return shortcut.NoModifier, key
+	}
+
+	switch mod {
+	case "alt":
+		return shortcut.AltModifier, k
+	case "ctrl":
+		return shortcut.ControlModifier, k
+	case "shift":
+		return shortcut.ShiftModifier, k
+	}
+
+	return shortcut.OtherModifier, key
 }
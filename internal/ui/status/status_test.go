@@ -1,17 +1,23 @@
 package status_test
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/mikelorant/committed/internal/commit"
 	"github.com/mikelorant/committed/internal/config"
+	"github.com/mikelorant/committed/internal/keymap"
+	"github.com/mikelorant/committed/internal/lint"
 	"github.com/mikelorant/committed/internal/theme"
 	"github.com/mikelorant/committed/internal/ui/status"
 	"github.com/mikelorant/committed/internal/ui/uitest"
 
 	"github.com/hexops/autogold/v2"
+	"github.com/stretchr/testify/assert"
 )
 
+var errMock = errors.New("unable to run editor: exit status 1")
+
 const (
 	globalShortcuts = iota
 	helpShortcuts
@@ -21,9 +27,13 @@ func TestModel(t *testing.T) {
 	t.Parallel()
 
 	type args struct {
-		shortcuts int
-		next      string
-		previous  string
+		shortcuts   int
+		next        string
+		previous    string
+		keys        map[string][]string
+		diagnostics []lint.Result
+		mode        string
+		err         error
 	}
 
 	type want struct{}
@@ -62,6 +72,87 @@ func TestModel(t *testing.T) {
 				previous: "previous",
 			},
 		},
+		{
+			name: "diagnostic_warning",
+			args: args{
+				diagnostics: []lint.Result{
+					{
+						Rule:     "summary-period",
+						Severity: config.SeverityWarning,
+						Problem:  lint.Problem{Line: 1, Column: 5, Message: "Summary should not end with a period."},
+					},
+				},
+			},
+		},
+		{
+			name: "diagnostic_multiple",
+			args: args{
+				diagnostics: []lint.Result{
+					{
+						Rule:     "blank-line",
+						Severity: config.SeverityError,
+						Problem:  lint.Problem{Line: 2, Column: 1, Message: "Summary should be followed by a blank line."},
+					},
+					{
+						Rule:     "summary-period",
+						Severity: config.SeverityWarning,
+						Problem:  lint.Problem{Line: 1, Column: 5, Message: "Summary should not end with a period."},
+					},
+				},
+			},
+		},
+		{
+			name: "mode",
+			args: args{
+				mode: "NORMAL",
+			},
+		},
+		{
+			name: "mode_diagnostic",
+			args: args{
+				mode: "INSERT",
+				diagnostics: []lint.Result{
+					{
+						Rule:     "summary-period",
+						Severity: config.SeverityWarning,
+						Problem:  lint.Problem{Line: 1, Column: 5, Message: "Summary should not end with a period."},
+					},
+				},
+			},
+		},
+		{
+			name: "error",
+			args: args{
+				err: errMock,
+			},
+		},
+		{
+			name: "mode_error_diagnostic",
+			args: args{
+				mode: "NORMAL",
+				err:  errMock,
+				diagnostics: []lint.Result{
+					{
+						Rule:     "summary-period",
+						Severity: config.SeverityWarning,
+						Problem:  lint.Problem{Line: 1, Column: 5, Message: "Summary should not end with a period."},
+					},
+				},
+			},
+		},
+		{
+			name: "keys",
+			args: args{
+				next:     "next",
+				previous: "previous",
+				keys: map[string][]string{
+					"commit":   {"ctrl+s"},
+					"help":     {"f1"},
+					"next":     {"ctrl+n"},
+					"previous": {"ctrl+b"},
+				},
+			},
+		},
 		{
 			name: "help",
 			args: args{
@@ -76,19 +167,27 @@ func TestModel(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
+			km, err := keymap.New(tt.args.keys)
+			assert.NoError(t, err)
+
 			state := &commit.State{
-				Theme: theme.New(config.ColourAdaptive),
+				Theme:  theme.New(config.ColourAdaptive),
+				Keymap: km,
 			}
 
 			m := status.New(state)
 
 			switch tt.args.shortcuts {
 			case helpShortcuts:
-				m.Shortcuts = status.HelpShortcuts()
+				m.Shortcuts = status.HelpShortcuts(state.Keymap)
 			default:
-				m.Shortcuts = status.GlobalShortcuts(tt.args.next, tt.args.previous)
+				m.Shortcuts = status.GlobalShortcuts(state.Keymap, tt.args.next, tt.args.previous)
 			}
 
+			m.Diagnostics = tt.args.diagnostics
+			m.Mode = tt.args.mode
+			m.Err = tt.args.err
+
 			m, _ = status.ToModel(m.Update(nil))
 
 			v := uitest.StripString(m.View())
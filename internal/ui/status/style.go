@@ -0,0 +1,50 @@
+package status
+
+import (
+	"github.com/mikelorant/committed/internal/theme"
+	"github.com/mikelorant/committed/internal/ui/colour"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+type Styles struct {
+	diagnostic        lipgloss.Style
+	diagnosticError   lipgloss.Style
+	diagnosticWarning lipgloss.Style
+	diagnosticText    lipgloss.Style
+	mode              lipgloss.Style
+}
+
+const (
+	errorMark   = "✖"
+	warningMark = "▲"
+)
+
+func defaultStyles(th theme.Theme) Styles {
+	var s Styles
+
+	clr := colour.New(th).Status()
+
+	s.diagnostic = lipgloss.NewStyle().
+		MarginLeft(1)
+
+	s.diagnosticError = lipgloss.NewStyle().
+		Foreground(clr.Error).
+		MarginRight(1).
+		SetString(errorMark)
+
+	s.diagnosticWarning = lipgloss.NewStyle().
+		Foreground(clr.Warning).
+		MarginRight(1).
+		SetString(warningMark)
+
+	s.diagnosticText = lipgloss.NewStyle().
+		Foreground(clr.Text)
+
+	s.mode = lipgloss.NewStyle().
+		Foreground(clr.Text).
+		Bold(true).
+		MarginLeft(1)
+
+	return s
+}
@@ -8,6 +8,9 @@ import (
 	"github.com/mikelorant/committed/internal/commit"
 	"github.com/mikelorant/committed/internal/config"
 	"github.com/mikelorant/committed/internal/emoji"
+	"github.com/mikelorant/committed/internal/keymap"
+	"github.com/mikelorant/committed/internal/lint"
+	"github.com/mikelorant/committed/internal/repository"
 	"github.com/mikelorant/committed/internal/ui/body"
 	"github.com/mikelorant/committed/internal/ui/colour"
 	"github.com/mikelorant/committed/internal/ui/footer"
@@ -37,6 +40,11 @@ type Model struct {
 	currentSave   savedState
 	previousSave  savedState
 	emojiType     config.EmojiType
+	linter        lint.Linter
+	linted        string
+	diagnostics   []lint.Result
+	statusErr     error
+	history       history
 }
 
 type Models struct {
@@ -50,10 +58,12 @@ type Models struct {
 }
 
 type savedState struct {
-	amend   bool
-	emoji   emoji.Emoji
-	summary string
-	body    string
+	amend        bool
+	emoji        emoji.Emoji
+	summary      string
+	conventional commit.Conventional
+	body         string
+	trailers     []repository.Trailer
 }
 
 type keyResponse struct {
@@ -69,8 +79,12 @@ const (
 	emptyComponent focus = iota
 	authorComponent
 	emojiComponent
+	typeComponent
+	scopeComponent
+	squashComponent
 	summaryComponent
 	bodyComponent
+	footerComponent
 	helpComponent
 )
 
@@ -86,28 +100,20 @@ const (
 	bodyDefaultHeight = 19
 	bodyAuthorHeight  = 12
 	bodyEmojiHeight   = 6
-
-	footerSignoffHeight = 2
+	diagnosticHeight  = 1
+	conflictHeight    = 1
 )
 
 const (
 	emptyName   = ""
 	authorName  = "Author"
 	emojiName   = "Emoji"
+	typeName    = "Type"
+	scopeName   = "Scope"
+	squashName  = "Squash"
 	summaryName = "Summary"
 	bodyName    = "Body"
-)
-
-const (
-	KeyAmend   = "å"
-	KeyLoad    = "¬"
-	KeySignoff = "ß"
-	KeyTheme   = "†"
-	KeyAuthor  = "¡"
-	KeyEmoji   = "™"
-	KeySummary = "£"
-	KeyBody    = "¢"
-	KeyHelp    = "˙"
+	footerName  = "Trailers"
 )
 
 const dateTimeFormat = "Mon Jan 2 15:04:05 2006 -0700"
@@ -120,7 +126,9 @@ func New() Model {
 
 func (m *Model) Configure(state *commit.State) {
 	m.state = state
+	m.linter = lint.New(state.Config)
 	m.defaults(state.Config)
+	m.defaultSource(state.File)
 
 	m.models = Models{
 		info:   info.New(state),
@@ -174,9 +182,19 @@ func (m Model) Init() tea.Cmd {
 
 //nolint:ireturn
 func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var before form
+
+	e := m.edit(msg)
+	if !e.skip {
+		before = m.form()
+	}
+
 	//nolint:gocritic
 	switch msgType := msg.(type) {
 	case tea.KeyMsg:
+		// An error is shown until the next key is pressed.
+		m.statusErr = nil
+
 		resp := m.onKeyPress(msgType)
 		switch {
 		case resp.end:
@@ -186,12 +204,21 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 
 		m = resp.model
+	case editorMsg:
+		m = m.onEditorExit(msgType)
+		msg = nil
 	}
 
 	m = m.resetModels()
 	m = m.setModels()
 
-	return m.updateModels(msg)
+	m, cmd := m.updateModels(msg)
+
+	if !e.skip {
+		m.history = m.history.record(before, m.form(), e, time.Now())
+	}
+
+	return m, cmd
 }
 
 func (m Model) View() string {
@@ -218,7 +245,7 @@ func (m Model) View() string {
 		)
 	}
 
-	if !m.models.footer.Signoff {
+	if m.models.footer.Height() == 0 {
 		return lipgloss.JoinVertical(lipgloss.Top,
 			m.models.info.View(),
 			m.models.header.View(),
@@ -237,39 +264,67 @@ func (m Model) View() string {
 }
 
 func (m Model) onKeyPress(msg tea.KeyMsg) keyResponse {
-	switch msg.String() {
-	case "alt+1", KeyAuthor:
+	key := msg.String()
+	action, _ := m.state.Keymap.Action(key)
+
+	switch {
+	case action == keymap.FocusAuthor:
 		if m.focus == authorComponent {
 			return keyResponse{model: m, nilMsg: true}
 		}
 		m.focus = authorComponent
-	case "alt+2", KeyEmoji:
+	case action == keymap.FocusEmoji:
 		if m.focus == emojiComponent {
 			return keyResponse{model: m, nilMsg: true}
 		}
 		m.focus = emojiComponent
-	case "alt+3", KeySummary:
+	case action == keymap.FocusSummary:
 		if m.focus == summaryComponent {
 			return keyResponse{model: m, nilMsg: true}
 		}
 		m.focus = summaryComponent
-	case "alt+4", KeyBody:
+	case action == keymap.FocusBody:
 		if m.focus == bodyComponent {
 			return keyResponse{model: m, nilMsg: true}
 		}
 		m.focus = bodyComponent
-	case "enter":
+	case action == keymap.FocusTrailers:
+		if m.focus == footerComponent {
+			return keyResponse{model: m, nilMsg: true}
+		}
+		m.focus = footerComponent
+	case key == "enter":
 		switch m.focus {
 		case authorComponent:
 			m.models.info, _ = info.ToModel(m.models.info.Update(msg))
 			m.focus = emojiComponent
 		case emojiComponent:
 			m.models.header, _ = header.ToModel(m.models.header.Update(msg))
+			m.focus = m.afterEmoji()
+
+			if m.focus == typeComponent || m.focus == squashComponent {
+				return keyResponse{model: m, nilMsg: true}
+			}
+		case typeComponent:
+			m.models.header, _ = header.ToModel(m.models.header.Update(msg))
+			m.focus = scopeComponent
+
+			return keyResponse{model: m, nilMsg: true}
+		case scopeComponent:
+			m.models.header, _ = header.ToModel(m.models.header.Update(msg))
+			m.focus = m.afterScope()
+
+			if m.focus == squashComponent {
+				return keyResponse{model: m, nilMsg: true}
+			}
+		case squashComponent:
+			m.models.header, _ = header.ToModel(m.models.header.Update(msg))
+			m.pickSquash()
 			m.focus = summaryComponent
 		case summaryComponent:
 			m.focus = bodyComponent
 		}
-	case "alt+enter", "alt+\\":
+	case action == keymap.Commit:
 		if !m.validate() {
 			break
 		}
@@ -277,7 +332,7 @@ func (m Model) onKeyPress(msg tea.KeyMsg) keyResponse {
 		m = m.commit(applyQuit)
 
 		return keyResponse{model: m, cmd: tea.Quit, end: true}
-	case "alt+a", KeyAmend:
+	case action == keymap.Amend:
 		m.amend = !m.amend
 
 		m.swapSave()
@@ -286,50 +341,90 @@ func (m Model) onKeyPress(msg tea.KeyMsg) keyResponse {
 		m.models.body.CursorStart()
 
 		return keyResponse{model: m, end: false, nilMsg: true}
-	case "alt+l", KeyLoad:
+	case action == keymap.Load:
 		if m.setSave() {
 			m.models.header.CursorStartSummary()
 			m.models.body.CursorStart()
 		}
 
 		return keyResponse{model: m, end: false, nilMsg: true}
-	case "alt+s", KeySignoff:
+	case action == keymap.Signoff:
 		m.signoff = !m.signoff
 
 		return keyResponse{model: m, end: false, nilMsg: true}
-	case "alt+t", KeyTheme:
+	case action == keymap.Breaking:
+		if !m.conventional() {
+			break
+		}
+
+		m.models.header.ToggleBreaking()
+
+		return keyResponse{model: m, end: false, nilMsg: true}
+	case action == keymap.Theme:
 		m.state.Theme.Next()
 		return keyResponse{model: m, cmd: colour.Update, end: true}
-	case "ctrl+h", KeyHelp:
+	case action == keymap.Editor:
+		return keyResponse{model: m, cmd: m.editBody(), end: true}
+	case action == keymap.Undo:
+		m = m.undo()
+
+		return keyResponse{model: m, end: false, nilMsg: true}
+	case action == keymap.Redo:
+		m = m.redo()
+
+		return keyResponse{model: m, end: false, nilMsg: true}
+	case action == keymap.Reflow:
+		if m.focus == bodyComponent {
+			m.models.body.Reflow()
+		}
+
+		return keyResponse{model: m, end: false, nilMsg: true}
+	case action == keymap.Help:
 		if m.focus == helpComponent {
 			m.focus = m.previousFocus
 			break
 		}
 		m.previousFocus = m.focus
 		m.focus = helpComponent
-	case "esc":
+	case key == "esc":
 		if m.focus == helpComponent {
 			m.focus = m.previousFocus
 		}
-	case "tab":
+	case action == keymap.Next:
 		switch m.focus {
 		case authorComponent:
 			m.focus = emojiComponent
 		case emojiComponent:
+			m.focus = m.afterEmoji()
+		case typeComponent:
+			m.focus = scopeComponent
+		case scopeComponent:
+			m.focus = m.afterScope()
+		case squashComponent:
 			m.focus = summaryComponent
 		case summaryComponent:
 			m.focus = bodyComponent
+		case bodyComponent:
+			m.focus = footerComponent
 		}
-	case "shift+tab":
+	case action == keymap.Previous:
 		switch m.focus {
 		case emojiComponent:
 			m.focus = authorComponent
-		case summaryComponent:
+		case typeComponent:
 			m.focus = emojiComponent
+		case scopeComponent:
+			m.focus = typeComponent
+		case squashComponent:
+			m.focus = m.beforeSquash()
+		case summaryComponent:
+			m.focus = m.beforeSummary()
 		case bodyComponent:
 			m.focus = summaryComponent
+		case footerComponent:
+			m.focus = bodyComponent
 		}
-	case "ctrl+c":
+	case action == keymap.Cancel:
 		m = m.commit(cancelQuit)
 
 		return keyResponse{model: m, cmd: tea.Quit, end: true}
@@ -347,6 +442,7 @@ func (m Model) resetModels() Model {
 	m.models.body.Height = bodyDefaultHeight
 	m.models.footer.Author = m.models.info.Author
 	m.models.footer.Signoff = m.signoff
+	m.models.footer.Blur()
 	m.models.help.Blur()
 
 	return m
@@ -358,38 +454,92 @@ func (m Model) setModels() Model {
 		m.models.info.Focus()
 		m.models.info.Expand = true
 		m.models.body.Height = bodyAuthorHeight
-		m.models.status.Shortcuts = status.GlobalShortcuts(emojiName, emptyName)
+		m.models.status.Shortcuts = status.GlobalShortcuts(m.state.Keymap, emojiName, emptyName)
 	case emojiComponent:
 		m.models.header.Focus()
 		m.models.header.SelectEmoji()
 		m.models.header.Expand = true
 		m.models.body.Height = bodyEmojiHeight
-		m.models.status.Shortcuts = status.GlobalShortcuts(summaryName, authorName)
+		m.models.status.Shortcuts = status.GlobalShortcuts(m.state.Keymap, m.afterEmoji().name(), authorName)
+	case typeComponent:
+		m.models.header.Focus()
+		m.models.header.SelectType()
+		m.models.header.Expand = true
+		m.models.body.Height = bodyEmojiHeight
+		m.models.status.Shortcuts = status.GlobalShortcuts(m.state.Keymap, scopeName, emojiName)
+	case scopeComponent:
+		m.models.header.Focus()
+		m.models.header.SelectScope()
+		m.models.header.Expand = true
+		m.models.body.Height = bodyEmojiHeight
+		m.models.status.Shortcuts = status.GlobalShortcuts(m.state.Keymap, m.afterScope().name(), typeName)
+	case squashComponent:
+		m.models.header.Focus()
+		m.models.header.SelectSquash()
+		m.models.header.Expand = true
+		m.models.body.Height = bodyEmojiHeight
+		m.models.status.Shortcuts = status.GlobalShortcuts(m.state.Keymap, summaryName, m.beforeSquash().name())
 	case summaryComponent:
 		m.models.header.Focus()
 		m.models.header.SelectSummary()
-		m.models.status.Shortcuts = status.GlobalShortcuts(bodyName, emojiName)
+		m.models.status.Shortcuts = status.GlobalShortcuts(m.state.Keymap, bodyName, m.beforeSummary().name())
 	case bodyComponent:
 		m.models.body.Focus()
-		m.models.status.Shortcuts = status.GlobalShortcuts(emptyName, summaryName)
+		m.models.status.Shortcuts = status.GlobalShortcuts(m.state.Keymap, footerName, summaryName)
+	case footerComponent:
+		m.models.footer.Focus()
+		m.models.status.Shortcuts = status.GlobalShortcuts(m.state.Keymap, emptyName, bodyName)
 	case helpComponent:
-		m.models.status.Shortcuts = status.HelpShortcuts()
+		m.models.status.Shortcuts = status.HelpShortcuts(m.state.Keymap)
 		m.models.help.Focus()
 	}
 
-	if m.signoff {
-		m.models.body.Height -= footerSignoffHeight
+	return m
+}
+
+// editMode returns the vim mode of the focused text, or nothing when the
+// focused component is not edited as text.
+func (m Model) editMode() string {
+	switch m.focus {
+	case summaryComponent:
+		return m.models.header.SummaryMode()
+	case bodyComponent:
+		return m.models.body.Mode()
 	}
 
-	return m
+	return ""
 }
 
 func (m Model) updateModels(msg tea.Msg) (Model, tea.Cmd) {
 	cmds := make([]tea.Cmd, 6)
 	m.models.info, cmds[0] = info.ToModel(m.models.info.Update(msg))
 	m.models.header, cmds[1] = header.ToModel(m.models.header.Update(msg))
-	m.models.body, cmds[2] = body.ToModel(m.models.body.Update(msg))
 	m.models.footer, cmds[3] = footer.ToModel(m.models.footer.Update(msg))
+
+	// Footer height can change with the message so is applied afterwards.
+	m.models.body.Height -= m.models.footer.Height()
+
+	if len(m.models.info.Conflicts) > 0 {
+		m.models.body.Height -= conflictHeight
+	}
+	m.models.body, cmds[2] = body.ToModel(m.models.body.Update(msg))
+
+	// Diagnostics are based on the updated message and shown in the status.
+	m = m.lint()
+	m.models.header.Diagnostics = m.diagnostics
+	m.models.status.Diagnostics = m.diagnostics
+	m.models.status.Mode = m.editMode()
+	m.models.status.Err = m.statusErr
+
+	switch {
+	case m.focus == helpComponent:
+		m.models.status.Diagnostics = nil
+		m.models.status.Err = nil
+	case len(m.diagnostics) > 0 || m.models.status.Mode != "" || m.statusErr != nil:
+		m.models.body.Height -= diagnosticHeight
+		m.models.body, _ = body.ToModel(m.models.body.Update(nil))
+	}
+
 	m.models.status, cmds[4] = status.ToModel(m.models.status.Update(msg))
 	m.models.help, cmds[5] = help.ToModel(m.models.help.Update(msg))
 
@@ -403,21 +553,15 @@ func (m Model) updateModels(msg tea.Msg) (Model, tea.Cmd) {
 func (m Model) commit(q quit) Model {
 	m.quit = q
 
-	var emoji string
-
-	switch m.emojiType {
-	case config.EmojiTypeCharacter:
-		emoji = m.models.header.Emoji.Character
-	default:
-		emoji = m.models.header.Emoji.Shortcode
-	}
+	emoji := m.emoji()
+	conv := m.models.header.Conventional
 
 	if m.quit == applyQuit {
 		m.models.message = message.New(message.State{
 			Emoji:   emoji,
-			Summary: m.models.header.Summary(),
+			Summary: commit.EmojiSummaryToSubject("", m.models.header.Summary(), conv),
 			Body:    m.models.body.Value(),
-			Footer:  m.models.footer.Value(),
+			Footer:  repository.TrailersToString(m.models.footer.Value()),
 			Theme:   m.state.Theme,
 		})
 	}
@@ -425,13 +569,19 @@ func (m Model) commit(q quit) Model {
 	m.Request = &commit.Request{
 		Author:      m.models.info.Author,
 		Emoji:       emoji,
+		Shortcode:   m.models.header.Emoji.Shortcode,
 		Summary:     m.models.header.Summary(),
+		Type:        conv.Type,
+		Scope:       conv.Scope,
+		Breaking:    conv.Breaking,
 		Body:        m.models.body.Value(),
 		RawBody:     m.models.body.RawValue(),
-		Footer:      m.models.footer.Value(),
+		Trailers:    m.models.footer.Value(),
+		RawTrailers: m.models.footer.RawValue(),
 		Amend:       m.amend,
 		File:        m.file,
 		MessageFile: m.state.Options.File.MessageFile,
+		Favourites:  m.models.header.Favourites,
 	}
 
 	if m.quit == applyQuit {
@@ -446,8 +596,105 @@ func (m Model) commit(q quit) Model {
 func (m Model) validate() bool {
 	staged := m.state.Repository.Worktree.IsStaged()
 	summary := m.models.header.Summary()
+	typed := !m.conventional() || m.models.header.Conventional.Type != ""
+	linted := !lint.HasErrors(m.diagnostics)
+
+	return (staged || m.amend) && linted && ((summary != "" && typed) || m.file)
+}
+
+// lint checks the message when it has changed since it was last checked.
+func (m Model) lint() Model {
+	var msg string
+
+	if m.models.header.Summary() != "" {
+		subject := commit.EmojiSummaryToSubject(m.emoji(), m.models.header.Summary(), m.models.header.Conventional)
+		msg = commit.ComposeMessage(subject, m.models.body.Value(), m.models.footer.Value())
+	}
+
+	if msg == m.linted {
+		return m
+	}
+
+	m.linted = msg
+	m.diagnostics = nil
+
+	if msg != "" {
+		m.diagnostics = m.linter.Lint(msg)
+	}
+
+	return m
+}
+
+func (m Model) emoji() string {
+	switch m.emojiType {
+	case config.EmojiTypeCharacter:
+		return m.models.header.Emoji.Character
+	default:
+		return m.models.header.Emoji.Shortcode
+	}
+}
+
+func (m Model) conventional() bool {
+	return m.state.Config.Commit.Style == config.StyleConventional
+}
+
+func (m Model) squash() bool {
+	return len(m.state.File.Squash) > 0
+}
+
+func (m Model) afterEmoji() focus {
+	if m.conventional() {
+		return typeComponent
+	}
+
+	return m.afterScope()
+}
+
+func (m Model) afterScope() focus {
+	if m.squash() {
+		return squashComponent
+	}
+
+	return summaryComponent
+}
+
+func (m Model) beforeSquash() focus {
+	if m.conventional() {
+		return scopeComponent
+	}
+
+	return emojiComponent
+}
+
+func (m Model) beforeSummary() focus {
+	if m.squash() {
+		return squashComponent
+	}
+
+	return m.beforeSquash()
+}
+
+func (f focus) name() string {
+	switch f {
+	case authorComponent:
+		return authorName
+	case emojiComponent:
+		return emojiName
+	case typeComponent:
+		return typeName
+	case scopeComponent:
+		return scopeName
+	case squashComponent:
+		return squashName
+	case summaryComponent:
+		return summaryName
+	case bodyComponent:
+		return bodyName
+	case footerComponent:
+		return footerName
+	}
 
-	return (staged || m.amend) && (summary != "" || m.file)
+	return emptyName
 }
 
 func (m *Model) resetCursor() {
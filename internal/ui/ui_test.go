@@ -2,6 +2,10 @@ package ui_test
 
 import (
 	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -336,6 +340,35 @@ func TestModel(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "tab_conventional",
+			args: args{
+				state: func(s *commit.State) {
+					s.Config.Commit.Style = config.StyleConventional
+				},
+				model: func(m ui.Model) ui.Model {
+					m, _ = ToModel(m.Update(nil))
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyTab}))
+					m, _ = ToModel(uitest.SendString(m, "feat"), nil)
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+					m, _ = ToModel(uitest.SendString(m, "api"), nil)
+					return m
+				},
+			},
+		},
+		{
+			name: "shift_tab_conventional",
+			args: args{
+				state: func(s *commit.State) {
+					s.Config.Commit.Style = config.StyleConventional
+				},
+				model: func(m ui.Model) ui.Model {
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'3'}, Alt: true}))
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyShiftTab}))
+					return m
+				},
+			},
+		},
 		{
 			name: "ctrl+c",
 			args: args{
@@ -393,9 +426,10 @@ func TestModel(t *testing.T) {
 			want: want{
 				model: func(m ui.Model) {
 					req := commit.Request{
-						Apply:   true,
-						Emoji:   ":art:",
-						Summary: "test",
+						Apply:     true,
+						Emoji:     ":art:",
+						Shortcode: ":art:",
+						Summary:   "test",
 						Author: repository.User{
 							Name:  "John Doe",
 							Email: "john.doe@example.com",
@@ -407,6 +441,66 @@ func TestModel(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "emoji_favourites_pinned",
+			args: args{
+				state: func(s *commit.State) {
+					s.Emojis.Emojis = append(s.Emojis.Emojis, emoji.Emoji{
+						Character:   "⚡️",
+						Description: "Improve performance.",
+						Shortcode:   ":zap:",
+					})
+					s.Favourites = []string{":zap:"}
+				},
+				model: func(m ui.Model) ui.Model {
+					m, _ = ToModel(m.Update(nil))
+
+					return m
+				},
+			},
+		},
+		{
+			name: "emoji_favourites",
+			args: args{
+				state: func(s *commit.State) {
+					s.Emojis.Emojis = append(s.Emojis.Emojis, emoji.Emoji{
+						Character:   "⚡️",
+						Description: "Improve performance.",
+						Shortcode:   ":zap:",
+					})
+					s.Favourites = []string{":zap:"}
+				},
+				model: func(m ui.Model) ui.Model {
+					m, _ = ToModel(m.Update(nil))
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyDown}))
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyCtrlP}))
+					m, _ = ToModel(m.Update(nil))
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+					m, _ = ToModel(uitest.SendString(m, "test"), nil)
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyEnter, Alt: true}))
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m ui.Model) {
+					req := commit.Request{
+						Apply:     true,
+						Emoji:     ":art:",
+						Shortcode: ":art:",
+						Summary:   "test",
+						Author: repository.User{
+							Name:  "John Doe",
+							Email: "john.doe@example.com",
+						},
+						Amend:      true,
+						Favourites: []string{":zap:", ":art:"},
+					}
+
+					assert.Equal(t, &req, m.Request)
+				},
+			},
+		},
 		{
 			name: "alt+enter_summary_body",
 			args: args{
@@ -453,9 +547,9 @@ func TestModel(t *testing.T) {
 			want: want{
 				model: func(m ui.Model) {
 					req := commit.Request{
-						Apply:   true,
-						Summary: "test",
-						Footer:  "Signed-off-by: John Doe <john.doe@example.com>",
+						Apply:    true,
+						Summary:  "test",
+						Trailers: []repository.Trailer{{Key: "Signed-off-by", Value: "John Doe <john.doe@example.com>"}},
 						Author: repository.User{
 							Name:  "John Doe",
 							Email: "john.doe@example.com",
@@ -497,6 +591,338 @@ func TestModel(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "alt+5",
+			args: args{
+				model: func(m ui.Model) ui.Model {
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'5'}, Alt: true}))
+					m, _ = ToModel(uitest.SendString(m, "Jane Doe <jane.doe@example.com>"), nil)
+					return m
+				},
+			},
+		},
+		{
+			name: "shift_tab_trailers",
+			args: args{
+				model: func(m ui.Model) ui.Model {
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'5'}, Alt: true}))
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyShiftTab}))
+					return m
+				},
+			},
+		},
+		{
+			name: "alt+enter_trailers",
+			args: args{
+				model: func(m ui.Model) ui.Model {
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'3'}, Alt: true}))
+					m, _ = ToModel(uitest.SendString(m, "test"), nil)
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'5'}, Alt: true}))
+					m, _ = ToModel(uitest.SendString(m, "Jane Doe <jane.doe@example.com>"), nil)
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'s'}, Alt: true}))
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyEnter, Alt: true}))
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m ui.Model) {
+					req := commit.Request{
+						Apply:   true,
+						Summary: "test",
+						Trailers: []repository.Trailer{
+							{Key: "Co-authored-by", Value: "Jane Doe <jane.doe@example.com>"},
+							{Key: "Signed-off-by", Value: "John Doe <john.doe@example.com>"},
+						},
+						RawTrailers: []repository.Trailer{
+							{Key: "Co-authored-by", Value: "Jane Doe <jane.doe@example.com>"},
+						},
+						Author: repository.User{
+							Name:  "John Doe",
+							Email: "john.doe@example.com",
+						},
+						Amend: true,
+					}
+
+					assert.Equal(t, &req, m.Request)
+				},
+			},
+		},
+		{
+			name: "alt+enter_coauthor",
+			args: args{
+				state: func(s *commit.State) {
+					s.Repository.Authors = []repository.User{
+						{Name: "Jane Doe", Email: "jane.doe@example.com"},
+					}
+				},
+				model: func(m ui.Model) ui.Model {
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'3'}, Alt: true}))
+					m, _ = ToModel(uitest.SendString(m, "test"), nil)
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'5'}, Alt: true}))
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyCtrlA}))
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyEnter, Alt: true}))
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m ui.Model) {
+					req := commit.Request{
+						Apply:   true,
+						Summary: "test",
+						Trailers: []repository.Trailer{
+							{Key: "Co-authored-by", Value: "Jane Doe <jane.doe@example.com>"},
+						},
+						RawTrailers: []repository.Trailer{
+							{Key: "Co-authored-by", Value: "Jane Doe <jane.doe@example.com>"},
+						},
+						Author: repository.User{
+							Name:  "John Doe",
+							Email: "john.doe@example.com",
+						},
+						Amend: true,
+					}
+
+					assert.Equal(t, &req, m.Request)
+				},
+			},
+		},
+		{
+			name: "ctrl+a_coauthor",
+			args: args{
+				state: func(s *commit.State) {
+					s.Repository.Authors = []repository.User{
+						{Name: "Jane Doe", Email: "jane.doe@example.com"},
+					}
+				},
+				model: func(m ui.Model) ui.Model {
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'5'}, Alt: true}))
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyCtrlA}))
+					return m
+				},
+			},
+		},
+		{
+			name: "alt+enter_conventional",
+			args: args{
+				state: func(s *commit.State) {
+					s.Config.Commit.Style = config.StyleConventional
+				},
+				model: func(m ui.Model) ui.Model {
+					m, _ = ToModel(m.Update(nil))
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyTab}))
+					m, _ = ToModel(uitest.SendString(m, "feat"), nil)
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+					m, _ = ToModel(uitest.SendString(m, "api"), nil)
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'b'}, Alt: true}))
+					m, _ = ToModel(uitest.SendString(m, "test"), nil)
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyEnter, Alt: true}))
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m ui.Model) {
+					req := commit.Request{
+						Apply:    true,
+						Summary:  "test",
+						Type:     "feat",
+						Scope:    "api",
+						Breaking: true,
+						Author: repository.User{
+							Name:  "John Doe",
+							Email: "john.doe@example.com",
+						},
+						Amend: true,
+					}
+
+					assert.Equal(t, &req, m.Request)
+				},
+			},
+		},
+		{
+			name: "alt+enter_conventional_no_type",
+			args: args{
+				state: func(s *commit.State) {
+					s.Config.Commit.Style = config.StyleConventional
+				},
+				model: func(m ui.Model) ui.Model {
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'3'}, Alt: true}))
+					m, _ = ToModel(uitest.SendString(m, "test"), nil)
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyEnter, Alt: true}))
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m ui.Model) {
+					assert.Nil(t, m.Request)
+				},
+			},
+		},
+		{
+			name: "alt+enter_lint_error",
+			args: args{
+				state: func(s *commit.State) {
+					s.Config.Lint.Words = []string{"wip"}
+				},
+				model: func(m ui.Model) ui.Model {
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'3'}, Alt: true}))
+					m, _ = ToModel(uitest.SendString(m, "WIP test"), nil)
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyEnter, Alt: true}))
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m ui.Model) {
+					assert.Nil(t, m.Request)
+				},
+			},
+		},
+		{
+			name: "alt+enter_lint_error_file",
+			args: args{
+				state: func(s *commit.State) {
+					s.Config.Lint.Words = []string{"wip"}
+					s.Options.File.MessageFile = "test"
+				},
+				model: func(m ui.Model) ui.Model {
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'3'}, Alt: true}))
+					m, _ = ToModel(uitest.SendString(m, "WIP test"), nil)
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyEnter, Alt: true}))
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m ui.Model) {
+					assert.Nil(t, m.Request)
+				},
+			},
+		},
+		{
+			name: "merge_conflicts",
+			args: args{
+				state: func(s *commit.State) {
+					s.Options.Amend = false
+					s.Options.File.MessageFile = "test"
+					s.File.Source = commit.SourceMerge
+					s.File.Message = "Merge branch 'test'\n\n# Conflicts:\n#\ta.go\n#\tb.go\n"
+					s.File.Conflicts = []string{"a.go", "b.go"}
+				},
+				model: func(m ui.Model) ui.Model {
+					m, _ = ToModel(m.Update(nil))
+					return m
+				},
+			},
+		},
+		{
+			name: "squash",
+			args: args{
+				state: func(s *commit.State) {
+					s.Options.Amend = false
+					s.Options.File.MessageFile = "test"
+					s.File.Source = commit.SourceSquash
+					s.File.Squash = []string{"first", "second"}
+				},
+				model: func(m ui.Model) ui.Model {
+					m, _ = ToModel(m.Update(nil))
+					return m
+				},
+			},
+		},
+		{
+			name: "squash_pick",
+			args: args{
+				state: func(s *commit.State) {
+					s.Options.Amend = false
+					s.Options.File.MessageFile = "test"
+					s.File.Source = commit.SourceSquash
+					s.File.Squash = []string{"first", "second"}
+				},
+				model: func(m ui.Model) ui.Model {
+					m, _ = ToModel(m.Update(nil))
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyDown}))
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+					return m
+				},
+			},
+		},
+		{
+			name: "squash_combine",
+			args: args{
+				state: func(s *commit.State) {
+					s.Options.Amend = false
+					s.Options.File.MessageFile = "test"
+					s.File.Source = commit.SourceSquash
+					s.File.Squash = []string{"first", "second"}
+				},
+				model: func(m ui.Model) ui.Model {
+					m, _ = ToModel(m.Update(nil))
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyDown}))
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyDown}))
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+					return m
+				},
+			},
+		},
+		{
+			name: "squash_message",
+			args: args{
+				state: func(s *commit.State) {
+					s.Options.Amend = false
+					s.Options.File.MessageFile = "test"
+					s.File.Source = commit.SourceSquash
+					s.File.Message = "# This is a combination of 2 commits.\n# This is the 1st commit message:\n\nfirst\n\nfirst body\n\nCo-authored-by: John Doe <john.doe@example.com>\n\n# This is the commit message #2:\n\nsecond\n\nSigned-off-by: John Doe <john.doe@example.com>\n"
+					s.File.Squash = []string{"first", "second"}
+				},
+				model: func(m ui.Model) ui.Model {
+					m, _ = ToModel(m.Update(nil))
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyDown}))
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+					return m
+				},
+			},
+		},
+		{
+			name: "squash_message_combine",
+			args: args{
+				state: func(s *commit.State) {
+					s.Options.Amend = false
+					s.Options.File.MessageFile = "test"
+					s.File.Source = commit.SourceSquash
+					s.File.Message = "# This is a combination of 2 commits.\n# This is the 1st commit message:\n\nfirst\n\nfirst body\n\nCo-authored-by: John Doe <john.doe@example.com>\n\n# This is the commit message #2:\n\nsecond\n\nSigned-off-by: John Doe <john.doe@example.com>\n"
+					s.File.Squash = []string{"first", "second"}
+				},
+				model: func(m ui.Model) ui.Model {
+					m, _ = ToModel(m.Update(nil))
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyDown}))
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyDown}))
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+					return m
+				},
+			},
+		},
+		{
+			name: "squash_shift+tab",
+			args: args{
+				state: func(s *commit.State) {
+					s.Options.Amend = false
+					s.Options.File.MessageFile = "test"
+					s.File.Squash = []string{"first"}
+				},
+				model: func(m ui.Model) ui.Model {
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'3'}, Alt: true}))
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyShiftTab}))
+					return m
+				},
+			},
+		},
 		{
 			name: "alt+enter_invalid",
 			args: args{
@@ -546,6 +972,41 @@ func TestModel(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "config_edit_mode_vim_summary",
+			args: args{
+				state: func(s *commit.State) {
+					s.Config.View.Focus = config.FocusSummary
+					s.Config.View.EditMode = config.EditModeVim
+				},
+				model: func(m ui.Model) ui.Model {
+					m, _ = ToModel(m.Update(nil))
+					m, _ = ToModel(uitest.SendString(m, "one two three"), nil)
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyEscape}))
+					m, _ = ToModel(uitest.SendString(m, "0dw"), nil)
+					return m
+				},
+			},
+		},
+		{
+			name: "config_edit_mode_vim_body",
+			args: args{
+				state: func(s *commit.State) {
+					s.Config.View.Focus = config.FocusSummary
+					s.Config.View.EditMode = config.EditModeVim
+				},
+				model: func(m ui.Model) ui.Model {
+					m, _ = ToModel(m.Update(nil))
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyTab}))
+					m, _ = ToModel(uitest.SendString(m, "one"), nil)
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+					m, _ = ToModel(uitest.SendString(m, "two"), nil)
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyEscape}))
+					m, _ = ToModel(uitest.SendString(m, "yykp"), nil)
+					return m
+				},
+			},
+		},
 		{
 			name: "config_signoff_off",
 			args: args{
@@ -588,9 +1049,10 @@ func TestModel(t *testing.T) {
 			want: want{
 				model: func(m ui.Model) {
 					req := commit.Request{
-						Apply:   true,
-						Emoji:   ":art:",
-						Summary: "test",
+						Apply:     true,
+						Emoji:     ":art:",
+						Shortcode: ":art:",
+						Summary:   "test",
 						Author: repository.User{
 							Name:  "John Doe",
 							Email: "john.doe@example.com",
@@ -620,9 +1082,10 @@ func TestModel(t *testing.T) {
 			want: want{
 				model: func(m ui.Model) {
 					req := commit.Request{
-						Apply:   true,
-						Emoji:   "🎨",
-						Summary: "test",
+						Apply:     true,
+						Emoji:     "🎨",
+						Shortcode: ":art:",
+						Summary:   "test",
 						Author: repository.User{
 							Name:  "John Doe",
 							Email: "john.doe@example.com",
@@ -634,6 +1097,88 @@ func TestModel(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "undo_typing",
+			args: args{
+				state: func(s *commit.State) {
+					s.Config.View.Focus = config.FocusSummary
+				},
+				model: func(m ui.Model) ui.Model {
+					m, _ = ToModel(m.Update(nil))
+					m, _ = ToModel(uitest.SendString(m, "summary"), nil)
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyTab}))
+					m, _ = ToModel(uitest.SendString(m, "body"), nil)
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyCtrlZ}))
+					return m
+				},
+			},
+		},
+		{
+			name: "undo_all",
+			args: args{
+				state: func(s *commit.State) {
+					s.Config.View.Focus = config.FocusSummary
+				},
+				model: func(m ui.Model) ui.Model {
+					m, _ = ToModel(m.Update(nil))
+					m, _ = ToModel(uitest.SendString(m, "summary"), nil)
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyTab}))
+					m, _ = ToModel(uitest.SendString(m, "body"), nil)
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyCtrlZ}))
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyCtrlZ}))
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyCtrlZ}))
+					return m
+				},
+			},
+		},
+		{
+			name: "redo",
+			args: args{
+				state: func(s *commit.State) {
+					s.Config.View.Focus = config.FocusSummary
+				},
+				model: func(m ui.Model) ui.Model {
+					m, _ = ToModel(m.Update(nil))
+					m, _ = ToModel(uitest.SendString(m, "summary"), nil)
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyCtrlZ}))
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyCtrlY}))
+					return m
+				},
+			},
+		},
+		{
+			name: "undo_amend",
+			args: args{
+				state: func(s *commit.State) {
+					s.Repository.Head.Message = ":art: summary\n\nbody\n"
+					s.Options.Amend = false
+				},
+				model: func(m ui.Model) ui.Model {
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+					m, _ = ToModel(uitest.SendString(m, "test"), nil)
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'a'}, Alt: true}))
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyCtrlZ}))
+					return m
+				},
+			},
+		},
+		{
+			name: "undo_snapshot_load",
+			args: args{
+				state: func(s *commit.State) {
+					s.Snapshot.Summary = "snapshot"
+					s.Snapshot.Body = "snapshot body"
+					s.Options.Amend = false
+				},
+				model: func(m ui.Model) ui.Model {
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+					m, _ = ToModel(uitest.SendString(m, "new"), nil)
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'l'}, Alt: true}))
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyCtrlZ}))
+					return m
+				},
+			},
+		},
 		{
 			name: "amend_empty",
 			args: args{
@@ -666,6 +1211,65 @@ func TestModel(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "amend_trailers",
+			args: args{
+				state: func(s *commit.State) {
+					s.Repository.Head.Message = ":art: summary\n\nbody\n\nFixes: #123\nCo-authored-by: Jane Doe <jane.doe@example.com>\n"
+					s.Options.Amend = true
+				},
+				model: func(m ui.Model) ui.Model {
+					m, _ = ToModel(m.Update(nil))
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyEnter, Alt: true}))
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m ui.Model) {
+					req := commit.Request{
+						Apply:     true,
+						Emoji:     ":art:",
+						Shortcode: ":art:",
+						Summary:   "summary",
+						Body:      "body",
+						RawBody:   "body",
+						Trailers: []repository.Trailer{
+							{Key: "Fixes", Value: "#123"},
+							{Key: "Co-authored-by", Value: "Jane Doe <jane.doe@example.com>"},
+						},
+						RawTrailers: []repository.Trailer{
+							{Key: "Fixes", Value: "#123"},
+							{Key: "Co-authored-by", Value: "Jane Doe <jane.doe@example.com>"},
+						},
+						Author: repository.User{
+							Name:  "John Doe",
+							Email: "john.doe@example.com",
+						},
+						Amend: true,
+					}
+
+					assert.Equal(t, &req, m.Request)
+				},
+			},
+		},
+		{
+			name: "snapshot_trailers",
+			args: args{
+				state: func(s *commit.State) {
+					s.Snapshot.Summary = "summary"
+					s.Snapshot.Trailers = []repository.Trailer{
+						{Key: "Refs", Value: "#1"},
+					}
+					s.Snapshot.Restore = true
+					s.Options.Amend = false
+				},
+				model: func(m ui.Model) ui.Model {
+					m, _ = ToModel(m.Update(nil))
+					return m
+				},
+			},
+		},
 		{
 			name: "snapshot_load_from_new_to_amend",
 			args: args{
@@ -799,6 +1403,97 @@ func TestModel(t *testing.T) {
 	}
 }
 
+func TestEditor(t *testing.T) {
+	tests := []struct {
+		name   string
+		script string
+		undo   bool
+		want   string
+	}{
+		{
+			name:   "edit",
+			script: "#!/bin/sh\nprintf 'edited body\\n' > \"$1\"\n",
+			want:   "edited body",
+		},
+		{
+			name:   "undo",
+			script: "#!/bin/sh\nprintf 'edited body\\n' > \"$1\"\n",
+			undo:   true,
+			want:   "edited body",
+		},
+		{
+			name:   "error",
+			script: "#!/bin/sh\nexit 1\n",
+			want:   "unable to run editor: exit status 1",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			editor := filepath.Join(t.TempDir(), "editor")
+			err := os.WriteFile(editor, []byte(tt.script), 0o755)
+			assert.NoError(t, err)
+
+			t.Setenv("GIT_EDITOR", editor)
+
+			c := testState()
+
+			m := ui.New()
+			m.Date = time.Date(2022, time.January, 1, 1, 0, 0, 0, time.UTC)
+			m.Configure(&c)
+
+			p := tea.NewProgram(editorModel{Model: m, want: tt.want, undo: tt.undo},
+				tea.WithInput(strings.NewReader("")),
+				tea.WithOutput(io.Discard),
+				tea.WithoutSignalHandler(),
+			)
+
+			timeout := time.AfterFunc(5*time.Second, p.Quit)
+			defer timeout.Stop()
+
+			go func() {
+				p.Send(tea.WindowSizeMsg{Width: 80, Height: 40})
+				p.Send(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'e'}, Alt: true})
+			}()
+
+			res, err := p.Run()
+			assert.NoError(t, err)
+
+			v := uitest.StripString(res.(editorModel).View())
+			if tt.undo {
+				assert.NotContains(t, v, tt.want)
+				return
+			}
+			assert.Contains(t, v, tt.want)
+		})
+	}
+}
+
+// editorModel quits once the view contains the result of the editor,
+// undoing the edit first when requested.
+type editorModel struct {
+	ui.Model
+	want string
+	undo bool
+}
+
+func (m editorModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	mm, cmd := ToModel(m.Model.Update(msg))
+	m.Model = mm
+
+	if strings.Contains(uitest.StripString(m.View()), m.want) {
+		if m.undo {
+			m.Model, _ = ToModel(m.Model.Update(tea.KeyMsg{Type: tea.KeyCtrlZ}))
+		}
+
+		return m, tea.Quit
+	}
+
+	return m, cmd
+}
+
 func testState() commit.State {
 	return commit.State{
 		Placeholders: commit.Placeholders{
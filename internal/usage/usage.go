@@ -0,0 +1,192 @@
+package usage
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/mikelorant/committed/internal/emoji"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Usage records the emojis used and pinned in each repository, keyed by the
+// worktree root.
+type Usage struct {
+	Repositories map[string]Record `yaml:"repositories,omitempty"`
+}
+
+// Record is the emoji usage of a repository. Emojis are keyed by
+// shortcode.
+type Record struct {
+	Favourites []string         `yaml:"favourites,omitempty,flow"`
+	Emojis     map[string]Entry `yaml:"emojis,omitempty"`
+}
+
+// Entry is the number of times an emoji was used and when it was last used.
+type Entry struct {
+	Count int       `yaml:"count"`
+	Last  time.Time `yaml:"last"`
+}
+
+const (
+	day   = 24 * time.Hour
+	week  = 7 * day
+	month = 30 * day
+)
+
+var (
+	errReader = errors.New("empty reader")
+	errWriter = errors.New("empty writer")
+)
+
+func (u *Usage) Load(fh io.Reader) (Usage, error) {
+	var usage Usage
+
+	if fh == nil {
+		return usage, errReader
+	}
+
+	err := yaml.NewDecoder(fh).Decode(&usage)
+	switch {
+	case err == nil:
+	case errors.Is(err, io.EOF):
+	default:
+		return usage, fmt.Errorf("unable to decode usage: %w", err)
+	}
+
+	return usage, nil
+}
+
+func (u *Usage) Save(fh io.WriteCloser, usage Usage) error {
+	if fh == nil {
+		return errWriter
+	}
+
+	err := yaml.NewEncoder(fh).Encode(&usage)
+	if err != nil {
+		return fmt.Errorf("unable to encode usage: %w", err)
+	}
+	defer fh.Close()
+
+	return nil
+}
+
+// Record returns the usage of a repository.
+func (u Usage) Record(repo string) Record {
+	return u.Repositories[repo]
+}
+
+// SetRecord replaces the usage of a repository.
+func (u *Usage) SetRecord(repo string, r Record) {
+	if u.Repositories == nil {
+		u.Repositories = make(map[string]Record)
+	}
+
+	u.Repositories[repo] = r
+}
+
+// Use counts a use of an emoji.
+func (r *Record) Use(shortcode string, now time.Time) {
+	if shortcode == "" {
+		return
+	}
+
+	if r.Emojis == nil {
+		r.Emojis = make(map[string]Entry)
+	}
+
+	e := r.Emojis[shortcode]
+	e.Count++
+	e.Last = now
+
+	r.Emojis[shortcode] = e
+}
+
+// Rank orders emojis from the highest score. The score is the number of
+// uses weighted by how recently the emoji was last used. Unused emojis keep
+// their order.
+func (r Record) Rank(emojis []emoji.Emoji, now time.Time) []emoji.Emoji {
+	res := make([]emoji.Emoji, len(emojis))
+	copy(res, emojis)
+
+	sort.SliceStable(res, func(i, j int) bool {
+		a, b := r.Emojis[res[i].Shortcode], r.Emojis[res[j].Shortcode]
+
+		if sa, sb := a.score(now), b.score(now); sa != sb {
+			return sa > sb
+		}
+
+		return a.Last.After(b.Last)
+	})
+
+	return res
+}
+
+// Pin moves the favourite emojis to the top, in the order they were pinned.
+func Pin(emojis []emoji.Emoji, favourites []string) []emoji.Emoji {
+	res := make([]emoji.Emoji, len(emojis))
+	copy(res, emojis)
+
+	sort.SliceStable(res, func(i, j int) bool {
+		a, b := index(favourites, res[i].Shortcode), index(favourites, res[j].Shortcode)
+
+		switch {
+		case a >= 0 && b >= 0:
+			return a < b
+		case b < 0:
+			return a >= 0
+		}
+
+		return false
+	})
+
+	return res
+}
+
+// Toggle pins an emoji after any other favourites or unpins it.
+func Toggle(favourites []string, shortcode string) []string {
+	if i := index(favourites, shortcode); i >= 0 {
+		return append(favourites[:i:i], favourites[i+1:]...)
+	}
+
+	return append(favourites[:len(favourites):len(favourites)], shortcode)
+}
+
+// Favourite reports if an emoji is pinned.
+func Favourite(favourites []string, shortcode string) bool {
+	return index(favourites, shortcode) >= 0
+}
+
+// score weights the number of uses so that an emoji used often in the past
+// gives way to one used recently.
+func (e Entry) score(now time.Time) float64 {
+	if e.Count == 0 {
+		return 0
+	}
+
+	age := now.Sub(e.Last)
+
+	switch {
+	case age < day:
+		return float64(e.Count) * 4
+	case age < week:
+		return float64(e.Count) * 2
+	case age < month:
+		return float64(e.Count)
+	}
+
+	return float64(e.Count) / 2
+}
+
+func index(vs []string, v string) int {
+	for i, s := range vs {
+		if s == v {
+			return i
+		}
+	}
+
+	return -1
+}
@@ -0,0 +1,222 @@
+package usage_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mikelorant/committed/internal/emoji"
+	"github.com/mikelorant/committed/internal/usage"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+type readWriteCloser struct {
+	bytes.Buffer
+}
+
+func (wc *readWriteCloser) Close() error {
+	return nil
+}
+
+var now = time.Date(2022, time.January, 31, 12, 0, 0, 0, time.UTC)
+
+func TestLoadSave(t *testing.T) {
+	t.Parallel()
+
+	data := heredoc.Doc(`
+		repositories:
+		  /repo:
+		    favourites: [':art:', ':bug:']
+		    emojis:
+		      ':zap:':
+		        count: 3
+		        last: 2022-01-31T12:00:00Z
+	`)
+
+	want := usage.Usage{
+		Repositories: map[string]usage.Record{
+			"/repo": {
+				Favourites: []string{":art:", ":bug:"},
+				Emojis: map[string]usage.Entry{
+					":zap:": {Count: 3, Last: now},
+				},
+			},
+		},
+	}
+
+	var u usage.Usage
+
+	got, err := u.Load(strings.NewReader(data))
+	assert.NoError(t, err)
+	assert.Equal(t, want, got)
+
+	var w readWriteCloser
+
+	err = u.Save(&w, got)
+	assert.NoError(t, err)
+
+	got, err = u.Load(&w)
+	assert.NoError(t, err)
+	assert.Equal(t, want, got)
+
+	got, err = u.Load(strings.NewReader(""))
+	assert.NoError(t, err)
+	assert.Equal(t, usage.Usage{}, got)
+
+	_, err = u.Load(nil)
+	assert.EqualError(t, err, "empty reader")
+
+	err = u.Save(nil, got)
+	assert.EqualError(t, err, "empty writer")
+
+	_, err = u.Load(strings.NewReader("repositories: []"))
+	assert.ErrorContains(t, err, "unable to decode usage")
+}
+
+func TestRank(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		emojis []string
+		record usage.Record
+	}
+
+	tests := []struct {
+		name string
+		args args
+		want []string
+	}{
+		{
+			name: "unused",
+			args: args{
+				emojis: []string{":art:", ":zap:", ":bug:"},
+			},
+			want: []string{":art:", ":zap:", ":bug:"},
+		},
+		{
+			name: "frequent",
+			args: args{
+				emojis: []string{":art:", ":zap:", ":bug:"},
+				record: usage.Record{
+					Emojis: map[string]usage.Entry{
+						":zap:": {Count: 1, Last: now.Add(-time.Hour)},
+						":bug:": {Count: 5, Last: now.Add(-time.Hour)},
+					},
+				},
+			},
+			want: []string{":bug:", ":zap:", ":art:"},
+		},
+		{
+			name: "recent",
+			args: args{
+				emojis: []string{":art:", ":zap:", ":bug:"},
+				record: usage.Record{
+					Emojis: map[string]usage.Entry{
+						":zap:": {Count: 2, Last: now.Add(-time.Hour)},
+						":bug:": {Count: 6, Last: now.Add(-60 * 24 * time.Hour)},
+					},
+				},
+			},
+			want: []string{":zap:", ":bug:", ":art:"},
+		},
+		{
+			name: "tie_most_recent",
+			args: args{
+				emojis: []string{":art:", ":zap:", ":bug:"},
+				record: usage.Record{
+					Emojis: map[string]usage.Entry{
+						":zap:": {Count: 1, Last: now.Add(-2 * time.Hour)},
+						":bug:": {Count: 1, Last: now.Add(-time.Hour)},
+					},
+				},
+			},
+			want: []string{":bug:", ":zap:", ":art:"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tt.args.record.Rank(emojis(tt.args.emojis...), now)
+			assert.Equal(t, emojis(tt.want...), got)
+		})
+	}
+}
+
+func TestUse(t *testing.T) {
+	t.Parallel()
+
+	var r usage.Record
+
+	r.Use(":art:", now.Add(-time.Hour))
+	r.Use(":art:", now)
+	r.Use("", now)
+
+	assert.Equal(t, map[string]usage.Entry{":art:": {Count: 2, Last: now}}, r.Emojis)
+}
+
+func TestPin(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		favourites []string
+		want       []string
+	}{
+		{
+			name: "none",
+			want: []string{":art:", ":zap:", ":bug:", ":memo:"},
+		},
+		{
+			name:       "pinned_order",
+			favourites: []string{":memo:", ":zap:"},
+			want:       []string{":memo:", ":zap:", ":art:", ":bug:"},
+		},
+		{
+			name:       "missing",
+			favourites: []string{":fire:", ":bug:"},
+			want:       []string{":bug:", ":art:", ":zap:", ":memo:"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := usage.Pin(emojis(":art:", ":zap:", ":bug:", ":memo:"), tt.favourites)
+			assert.Equal(t, emojis(tt.want...), got)
+		})
+	}
+}
+
+func TestToggle(t *testing.T) {
+	t.Parallel()
+
+	favs := usage.Toggle(nil, ":art:")
+	favs = usage.Toggle(favs, ":zap:")
+	assert.Equal(t, []string{":art:", ":zap:"}, favs)
+	assert.True(t, usage.Favourite(favs, ":zap:"))
+
+	unpinned := usage.Toggle(favs, ":art:")
+	assert.Equal(t, []string{":zap:"}, unpinned)
+	assert.Equal(t, []string{":art:", ":zap:"}, favs)
+	assert.False(t, usage.Favourite(unpinned, ":art:"))
+}
+
+func emojis(shortcodes ...string) []emoji.Emoji {
+	es := make([]emoji.Emoji, len(shortcodes))
+
+	for i, s := range shortcodes {
+		es[i] = emoji.Emoji{Shortcode: s}
+	}
+
+	return es
+}
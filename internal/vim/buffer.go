@@ -0,0 +1,261 @@
+package vim
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Buffer is the text being edited with the cursor as a line and a rune
+// column within the line.
+type Buffer struct {
+	Lines []string
+	Row   int
+	Col   int
+}
+
+// NewBuffer returns the buffer of a text with the cursor at a line and
+// column.
+func NewBuffer(text string, row, col int) Buffer {
+	b := Buffer{Lines: strings.Split(text, "\n"), Row: row, Col: col}
+
+	b.Row = clamp(b.Row, 0, len(b.Lines)-1)
+	b.Col = clamp(b.Col, 0, len([]rune(b.Lines[b.Row])))
+
+	return b
+}
+
+// String returns the text of the buffer.
+func (b Buffer) String() string {
+	return strings.Join(b.Lines, "\n")
+}
+
+// text is a buffer as runes with the cursor as an offset, which lets motions
+// cross lines.
+type text struct {
+	runes []rune
+	off   int
+}
+
+func (b Buffer) text() text {
+	t := text{runes: []rune(b.String())}
+
+	for i := 0; i < b.Row && i < len(b.Lines); i++ {
+		t.off += len([]rune(b.Lines[i])) + 1
+	}
+
+	t.off += b.Col
+
+	return t
+}
+
+func (t text) buffer() Buffer {
+	b := NewBuffer(string(t.runes), 0, 0)
+
+	off := clamp(t.off, 0, len(t.runes))
+	for _, r := range t.runes[:off] {
+		if r == '\n' {
+			b.Row++
+			b.Col = 0
+
+			continue
+		}
+
+		b.Col++
+	}
+
+	return b
+}
+
+// lineStart returns the offset of the start of the line of an offset.
+func (t text) lineStart(off int) int {
+	for off > 0 && t.runes[off-1] != '\n' {
+		off--
+	}
+
+	return off
+}
+
+// lineEnd returns the offset of the newline ending the line of an offset, or
+// the end of the text.
+func (t text) lineEnd(off int) int {
+	for off < len(t.runes) && t.runes[off] != '\n' {
+		off++
+	}
+
+	return off
+}
+
+// firstNonBlank returns the offset of the first character of a line that is
+// not a space or tab.
+func (t text) firstNonBlank(off int) int {
+	off = t.lineStart(off)
+	end := t.lineEnd(off)
+
+	for off < end && isBlank(t.runes[off]) {
+		off++
+	}
+
+	return off
+}
+
+// lineOffset returns the offset of a column within another line, limited to
+// the end of that line.
+func (t text) lineOffset(off, lines int) int {
+	col := off - t.lineStart(off)
+
+	for ; lines > 0 && t.lineEnd(off) < len(t.runes); lines-- {
+		off = t.lineEnd(off) + 1
+	}
+
+	for ; lines < 0 && t.lineStart(off) > 0; lines++ {
+		off = t.lineStart(off) - 1
+	}
+
+	start := t.lineStart(off)
+
+	return min(start+col, t.lineEnd(start))
+}
+
+// line returns the offset of the start of a line by index.
+func (t text) line(row int) int {
+	off := 0
+
+	for ; row > 0 && t.lineEnd(off) < len(t.runes); row-- {
+		off = t.lineEnd(off) + 1
+	}
+
+	return off
+}
+
+// normal limits the cursor to the last character of a line, as the cursor
+// is on a character outside insert mode.
+func (t text) normal() text {
+	start, end := t.lineStart(t.off), t.lineEnd(t.off)
+	if t.off >= end && end > start {
+		t.off = end - 1
+	}
+
+	return t
+}
+
+type class int
+
+const (
+	classBlank class = iota
+	classNewline
+	classWord
+	classPunct
+)
+
+func classOf(r rune) class {
+	switch {
+	case r == '\n':
+		return classNewline
+	case isBlank(r):
+		return classBlank
+	case r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r):
+		return classWord
+	}
+
+	return classPunct
+}
+
+func isBlank(r rune) bool {
+	return r == ' ' || r == '\t'
+}
+
+// wordForward returns the offset of the start of the next word. An empty line
+// is a word.
+func (t text) wordForward(off int) int {
+	n := len(t.runes)
+	if off >= n {
+		return n
+	}
+
+	if c := classOf(t.runes[off]); c == classWord || c == classPunct {
+		for off < n && classOf(t.runes[off]) == c {
+			off++
+		}
+	}
+
+	for off < n {
+		switch classOf(t.runes[off]) {
+		case classBlank:
+			off++
+		case classNewline:
+			off++
+			if off < n && t.runes[off] == '\n' {
+				return off
+			}
+		default:
+			return off
+		}
+	}
+
+	return off
+}
+
+// wordEnd returns the offset of the last character of the next word end.
+func (t text) wordEnd(off int) int {
+	n := len(t.runes)
+	off++
+
+	for off < n && (classOf(t.runes[off]) == classBlank || classOf(t.runes[off]) == classNewline) {
+		off++
+	}
+
+	if off >= n {
+		return max(n-1, 0)
+	}
+
+	c := classOf(t.runes[off])
+	for off+1 < n && classOf(t.runes[off+1]) == c {
+		off++
+	}
+
+	return off
+}
+
+// wordBackward returns the offset of the start of the previous word.
+func (t text) wordBackward(off int) int {
+	off--
+
+	for off > 0 && (classOf(t.runes[off]) == classBlank || classOf(t.runes[off]) == classNewline) {
+		if t.runes[off] == '\n' && t.runes[off-1] == '\n' {
+			return off
+		}
+
+		off--
+	}
+
+	if off <= 0 {
+		return 0
+	}
+
+	c := classOf(t.runes[off])
+	for off > 0 && classOf(t.runes[off-1]) == c {
+		off--
+	}
+
+	return off
+}
+
+func clamp(v, low, high int) int {
+	return max(low, min(v, high))
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+
+	return b
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+
+	return b
+}
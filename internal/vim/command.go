@@ -0,0 +1,157 @@
+package vim
+
+// command is a normal mode command: a count followed by an operator and its
+// motion, a motion, or an action.
+type command struct {
+	count    int
+	operator string
+	motion   string
+	action   string
+}
+
+type parseState int
+
+const (
+	parsePending parseState = iota
+	parseComplete
+	parseInvalid
+)
+
+var (
+	operators = map[string]bool{"d": true, "c": true, "y": true}
+
+	motions = map[string]bool{
+		"h": true, "j": true, "k": true, "l": true,
+		"w": true, "b": true, "e": true,
+		"0": true, "^": true, "$": true, "G": true,
+		"left": true, "right": true, "up": true, "down": true,
+		"backspace": true, "enter": true, " ": true,
+	}
+
+	actions = map[string]bool{
+		"x": true, "X": true, "D": true, "C": true,
+		"p": true, "P": true,
+		"i": true, "a": true, "I": true, "A": true, "o": true, "O": true,
+		"u": true, "ctrl+r": true, ".": true,
+		"v": true, "V": true, "esc": true,
+
+		// Visual mode actions for the selection.
+		"s": true, "Y": true,
+	}
+)
+
+func isOperator(key string) bool {
+	return operators[key]
+}
+
+// parse returns the command of the keys typed so far, or whether more keys
+// are needed.
+func parse(keys []string) (command, parseState) {
+	var cmd command
+
+	count, i := parseCount(keys, 0)
+	if i == len(keys) {
+		return cmd, parsePending
+	}
+
+	key := keys[i]
+	i++
+
+	switch {
+	case isOperator(key):
+		cmd.operator = key
+
+		var n int
+
+		n, i = parseCount(keys, i)
+		count = multiply(count, n)
+
+		if i == len(keys) {
+			return cmd, parsePending
+		}
+
+		key = keys[i]
+		i++
+
+		if key == cmd.operator {
+			cmd.motion = key
+
+			break
+		}
+
+		fallthrough
+	case key == "g" || motions[key]:
+		motion, j, st := parseMotion(keys, i-1)
+		if st != parseComplete {
+			return cmd, st
+		}
+
+		cmd.motion, i = motion, j
+	case actions[key]:
+		cmd.action = key
+	default:
+		return cmd, parseInvalid
+	}
+
+	if i != len(keys) {
+		return cmd, parseInvalid
+	}
+
+	cmd.count = count
+
+	return cmd, parseComplete
+}
+
+// parseMotion returns the motion starting at a key. The g prefix needs a
+// second key.
+func parseMotion(keys []string, i int) (string, int, parseState) {
+	switch {
+	case keys[i] != "g" && motions[keys[i]]:
+		return keys[i], i + 1, parseComplete
+	case keys[i] != "g":
+		return "", i, parseInvalid
+	case i+1 == len(keys):
+		return "", i, parsePending
+	case keys[i+1] == "g":
+		return "gg", i + 2, parseComplete
+	}
+
+	return "", i, parseInvalid
+}
+
+// parseCount returns a count starting at a key. A count cannot start with
+// zero as that is a motion.
+func parseCount(keys []string, i int) (int, int) {
+	n := 0
+
+	for ; i < len(keys); i++ {
+		k := keys[i]
+		if len(k) != 1 || k[0] < '0' || k[0] > '9' || (n == 0 && k == "0") {
+			break
+		}
+
+		n = n*10 + int(k[0]-'0')
+	}
+
+	return n, i
+}
+
+// times returns the number of times a command is applied.
+func (c command) times() int {
+	if c.count == 0 {
+		return 1
+	}
+
+	return c.count
+}
+
+func multiply(a, b int) int {
+	switch {
+	case a == 0:
+		return b
+	case b == 0:
+		return a
+	}
+
+	return a * b
+}
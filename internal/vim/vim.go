@@ -0,0 +1,576 @@
+// Package vim provides vim style modal editing of a text buffer. Keys typed
+// in insert mode are left to the text input, so only the normal and visual
+// mode commands are applied to the buffer.
+package vim
+
+import (
+	"strings"
+)
+
+// Mode is the editing mode.
+type Mode int
+
+const (
+	ModeInsert Mode = iota
+	ModeNormal
+	ModeVisual
+	ModeVisualLine
+)
+
+// Editor applies vim commands to a buffer. The zero value starts in insert
+// mode.
+type Editor struct {
+	// SingleLine prevents commands from adding lines, such as within a
+	// summary.
+	SingleLine bool
+
+	mode     Mode
+	pending  []string
+	register register
+	undo     []Buffer
+	redo     []Buffer
+	last     change
+	insert   insert
+	anchor   int
+}
+
+// register holds yanked or deleted text. Linewise text is put as whole
+// lines.
+type register struct {
+	text     string
+	linewise bool
+}
+
+// change is the last command that changed the buffer, with the text
+// typed when it entered insert mode, so that it can be repeated.
+type change struct {
+	cmd  command
+	text string
+	ok   bool
+}
+
+// insert is the buffer when insert mode was entered by a command.
+type insert struct {
+	cmd    command
+	before text
+	repeat bool
+}
+
+// New returns an editor starting in insert mode.
+func New(singleLine bool) Editor {
+	return Editor{SingleLine: singleLine}
+}
+
+// Mode returns the current mode.
+func (e Editor) Mode() Mode {
+	return e.mode
+}
+
+func (m Mode) String() string {
+	return []string{"INSERT", "NORMAL", "VISUAL", "VISUAL LINE"}[m]
+}
+
+// Update applies a key to the buffer. Keys that are not handled, such as
+// those typed in insert mode, are left to the text input.
+func (e *Editor) Update(key string, b Buffer) (Buffer, bool) {
+	switch e.mode {
+	case ModeInsert:
+		if key != "esc" {
+			return b, false
+		}
+
+		return e.leaveInsert(b.text()).buffer(), true
+	case ModeVisual, ModeVisualLine:
+		return e.visual(key, b.text()).buffer(), true
+	}
+
+	e.pending = append(e.pending, key)
+
+	cmd, st := parse(e.pending)
+	switch st {
+	case parsePending:
+		return b, true
+	case parseInvalid:
+		e.pending = nil
+
+		return b, true
+	}
+
+	e.pending = nil
+
+	return e.execute(cmd, b.text()).buffer(), true
+}
+
+// execute applies a normal mode command.
+func (e *Editor) execute(cmd command, t text) text {
+	switch cmd.action {
+	case "Y":
+		cmd = command{count: cmd.count, operator: "y", motion: "y"}
+	case "s":
+		cmd = command{count: cmd.count, operator: "c", motion: "l"}
+	}
+
+	switch {
+	case cmd.action == "u":
+		return e.undoChange(t, cmd.times())
+	case cmd.action == "ctrl+r":
+		return e.redoChange(t, cmd.times())
+	case cmd.action == ".":
+		return e.repeat(cmd, t)
+	case cmd.action == "v":
+		e.mode, e.anchor = ModeVisual, t.off
+
+		return t
+	case cmd.action == "V":
+		e.mode, e.anchor = ModeVisualLine, t.off
+
+		return t
+	case cmd.action == "esc":
+		return t
+	case cmd.operator == "" && cmd.action == "":
+		off, _, _ := e.motion(cmd, t)
+		t.off = off
+
+		return t.normal()
+	}
+
+	if cmd.operator == "y" {
+		start, end, linewise := e.span(cmd, t)
+		e.yank(t, start, end, linewise)
+
+		switch {
+		case !linewise:
+			t.off = start
+		case start < t.lineStart(t.off):
+			// The cursor keeps its column on the first yanked line.
+			t.off = t.lineOffset(t.off, -strings.Count(string(t.runes[start:t.off]), "\n"))
+		}
+
+		return t.normal()
+	}
+
+	e.save(t)
+	e.last = change{cmd: cmd, ok: true}
+
+	return e.change(cmd, t)
+}
+
+// change applies a command that changes the buffer.
+func (e *Editor) change(cmd command, t text) text {
+	switch cmd.action {
+	case "x":
+		return e.change(command{count: cmd.count, operator: "d", motion: "l"}, t)
+	case "X":
+		return e.change(command{count: cmd.count, operator: "d", motion: "h"}, t)
+	case "D":
+		return e.change(command{count: cmd.count, operator: "d", motion: "$"}, t)
+	case "C":
+		return e.change(command{count: cmd.count, operator: "c", motion: "$"}, t)
+	case "p", "P":
+		return e.put(t, cmd.action == "P", cmd.times()).normal()
+	case "i":
+		return e.enterInsert(cmd, t)
+	case "a":
+		if t.off < t.lineEnd(t.off) {
+			t.off++
+		}
+
+		return e.enterInsert(cmd, t)
+	case "I":
+		t.off = t.firstNonBlank(t.off)
+
+		return e.enterInsert(cmd, t)
+	case "A":
+		t.off = t.lineEnd(t.off)
+
+		return e.enterInsert(cmd, t)
+	case "o", "O":
+		if e.SingleLine {
+			t.off = t.lineEnd(t.off)
+
+			return e.enterInsert(cmd, t)
+		}
+
+		off := t.lineEnd(t.off)
+		if cmd.action == "O" {
+			off = t.lineStart(t.off)
+		}
+
+		t = t.replace(off, off, "\n")
+		if cmd.action == "o" {
+			off++
+		}
+
+		t.off = off
+
+		return e.enterInsert(cmd, t)
+	}
+
+	start, end, linewise := e.span(cmd, t)
+
+	e.yank(t, start, end, linewise)
+
+	if cmd.operator == "c" {
+		if linewise && end > start && t.runes[end-1] == '\n' {
+			// The lines are replaced by an empty line.
+			end--
+		}
+
+		t = t.replace(start, end, "")
+		t.off = start
+
+		return e.enterInsert(cmd, t)
+	}
+
+	if linewise {
+		switch {
+		case end < len(t.runes):
+			// The newline ending the last line is removed.
+		case start > 0:
+			// The newline before the last line of the text is removed.
+			start--
+		}
+	}
+
+	t = t.replace(start, end, "")
+	t.off = start
+
+	if linewise {
+		t.off = t.firstNonBlank(start)
+	}
+
+	return t.normal()
+}
+
+// span returns the range of text covered by an operator and its motion.
+func (e *Editor) span(cmd command, t text) (int, int, bool) {
+	if cmd.motion == cmd.operator {
+		// Doubled operators such as dd cover lines.
+		start := t.lineStart(t.off)
+		end := t.lineOffset(start, cmd.times()-1)
+		end = t.lineEnd(end)
+
+		if end < len(t.runes) {
+			end++
+		}
+
+		return start, end, true
+	}
+
+	if cmd.operator == "c" && cmd.motion == "w" && t.off < len(t.runes) {
+		// A change of a word leaves the blanks after it, as ce does.
+		if c := classOf(t.runes[t.off]); c == classWord || c == classPunct {
+			cmd.motion = "e"
+		}
+	}
+
+	off, linewise, inclusive := e.motion(cmd, t)
+
+	start, end := min(t.off, off), max(t.off, off)
+
+	switch {
+	case linewise:
+		start = t.lineStart(start)
+		end = t.lineEnd(end)
+
+		if end < len(t.runes) {
+			end++
+		}
+	case inclusive:
+		end = min(end+1, len(t.runes))
+	case cmd.motion == "w" && strings.ContainsRune(string(t.runes[start:end]), '\n'):
+		// A word motion does not cross the end of the line.
+		end = t.lineEnd(start)
+	}
+
+	return start, end, linewise
+}
+
+// motion returns the offset a motion moves the cursor to, and whether the
+// motion covers lines or includes the character at the offset.
+func (e *Editor) motion(cmd command, t text) (int, bool, bool) {
+	n := cmd.times()
+	off := t.off
+
+	switch cmd.motion {
+	case "h", "left", "backspace":
+		return max(t.lineStart(off), off-n), false, false
+	case "l", "right", " ":
+		return min(t.lineEnd(off), off+n), false, false
+	case "j", "down", "enter":
+		return t.lineOffset(off, n), true, false
+	case "k", "up":
+		return t.lineOffset(off, -n), true, false
+	case "0":
+		return t.lineStart(off), false, false
+	case "^":
+		return t.firstNonBlank(off), false, false
+	case "$":
+		return t.lineEnd(t.lineOffset(off, n-1)), false, false
+	case "w":
+		for i := 0; i < n; i++ {
+			off = t.wordForward(off)
+		}
+
+		return off, false, false
+	case "e":
+		for i := 0; i < n; i++ {
+			off = t.wordEnd(off)
+		}
+
+		return off, false, true
+	case "b":
+		for i := 0; i < n; i++ {
+			off = t.wordBackward(off)
+		}
+
+		return off, false, false
+	case "gg":
+		return t.firstNonBlank(t.line(max(cmd.count, 1) - 1)), true, false
+	case "G":
+		if cmd.count == 0 {
+			return t.firstNonBlank(len(t.runes)), true, false
+		}
+
+		return t.firstNonBlank(t.line(cmd.count - 1)), true, false
+	}
+
+	return off, false, false
+}
+
+// visual applies a key in visual mode. Motions extend the selection and
+// operators apply to it.
+func (e *Editor) visual(key string, t text) text {
+	if len(e.pending) == 0 && isOperator(key) {
+		// Operators apply to the selection without a motion.
+		key = map[string]string{"d": "x", "c": "s", "y": "Y"}[key]
+	}
+
+	e.pending = append(e.pending, key)
+
+	cmd, st := parse(e.pending)
+	switch st {
+	case parsePending:
+		return t
+	case parseInvalid:
+		e.pending = nil
+
+		return t
+	}
+
+	e.pending = nil
+
+	start, end := min(e.anchor, t.off), max(e.anchor, t.off)+1
+	linewise := e.mode == ModeVisualLine
+
+	if linewise {
+		start, end = t.lineStart(start), t.lineEnd(end-1)
+		if end < len(t.runes) {
+			end++
+		}
+	}
+
+	end = min(end, len(t.runes))
+
+	switch {
+	case cmd.action == "esc" || (cmd.action == "v" && !linewise) || (cmd.action == "V" && linewise):
+		e.mode = ModeNormal
+	case cmd.action == "v":
+		e.mode = ModeVisual
+	case cmd.action == "V":
+		e.mode = ModeVisualLine
+	case cmd.action == "o":
+		e.anchor, t.off = t.off, e.anchor
+	case cmd.action == "Y":
+		e.yank(t, start, end, linewise)
+		e.mode = ModeNormal
+		t.off = start
+	case cmd.action == "x" || cmd.action == "s":
+		e.save(t)
+		e.yank(t, start, end, linewise)
+		e.mode = ModeNormal
+
+		if cmd.action == "s" && linewise && end > start && t.runes[end-1] == '\n' {
+			end--
+		}
+
+		t = t.replace(start, end, "")
+		t.off = start
+
+		if cmd.action == "s" {
+			e.mode = ModeInsert
+			e.insert = insert{}
+
+			return t
+		}
+	case cmd.operator == "" && cmd.action == "":
+		off, _, _ := e.motion(cmd, t)
+		t.off = off
+	}
+
+	return t.normal()
+}
+
+// enterInsert starts insert mode so the typed text is left to the input.
+func (e *Editor) enterInsert(cmd command, t text) text {
+	e.mode = ModeInsert
+	e.insert = insert{cmd: cmd, before: t, repeat: true}
+
+	return t
+}
+
+// leaveInsert returns to normal mode, recording the typed text so that the
+// command can be repeated.
+func (e *Editor) leaveInsert(t text) text {
+	e.mode = ModeNormal
+
+	if e.insert.repeat {
+		e.last.text = typed(e.insert.before, t)
+
+		// An insert that changed nothing is not kept as a change.
+		if len(e.undo) > 0 && string(e.undo[len(e.undo)-1].text().runes) == string(t.runes) {
+			e.undo = e.undo[:len(e.undo)-1]
+		}
+	}
+
+	e.insert = insert{}
+
+	if t.off > t.lineStart(t.off) {
+		t.off--
+	}
+
+	return t.normal()
+}
+
+// typed returns the text typed from an offset when the rest of the text is
+// unchanged.
+func typed(before, after text) string {
+	n := len(after.runes) - len(before.runes)
+	if n < 0 || after.off != before.off+n {
+		return ""
+	}
+
+	if string(after.runes[:before.off]) != string(before.runes[:before.off]) ||
+		string(after.runes[after.off:]) != string(before.runes[before.off:]) {
+		return ""
+	}
+
+	return string(after.runes[before.off:after.off])
+}
+
+// repeat applies the last change again. A count replaces the count of the
+// change.
+func (e *Editor) repeat(cmd command, t text) text {
+	if !e.last.ok {
+		return t
+	}
+
+	last := e.last
+	if cmd.count > 0 {
+		last.cmd.count = cmd.count
+	}
+
+	t = e.execute(last.cmd, t)
+
+	if e.mode == ModeInsert {
+		t = t.replace(t.off, t.off, last.text)
+		t.off += len([]rune(last.text))
+		t = e.leaveInsert(t)
+	}
+
+	e.last = last
+
+	return t
+}
+
+func (e *Editor) yank(t text, start, end int, linewise bool) {
+	s := string(t.runes[start:end])
+	if linewise {
+		s = strings.TrimSuffix(s, "\n")
+	}
+
+	e.register = register{text: s, linewise: linewise}
+}
+
+// put inserts the register after or before the cursor.
+func (e *Editor) put(t text, before bool, n int) text {
+	r := e.register
+	if r.text == "" && !r.linewise {
+		return t
+	}
+
+	s := strings.Repeat(r.text, n)
+
+	if r.linewise && !e.SingleLine {
+		lines := strings.TrimSuffix(strings.Repeat(r.text+"\n", n), "\n")
+
+		if before {
+			off := t.lineStart(t.off)
+			t = t.replace(off, off, lines+"\n")
+			t.off = t.firstNonBlank(off)
+
+			return t
+		}
+
+		off := t.lineEnd(t.off)
+		t = t.replace(off, off, "\n"+lines)
+		t.off = t.firstNonBlank(off + 1)
+
+		return t
+	}
+
+	// An empty line put within a single line adds nothing.
+	if s == "" {
+		return t
+	}
+
+	off := t.off
+	if !before && off < t.lineEnd(off) {
+		off++
+	}
+
+	t = t.replace(off, off, s)
+	t.off = max(off, off+len([]rune(s))-1)
+
+	return t
+}
+
+func (e *Editor) save(t text) {
+	e.undo = append(e.undo, t.buffer())
+	e.redo = nil
+}
+
+func (e *Editor) undoChange(t text, n int) text {
+	for ; n > 0 && len(e.undo) > 0; n-- {
+		b := e.undo[len(e.undo)-1]
+		e.undo = e.undo[:len(e.undo)-1]
+		e.redo = append(e.redo, t.buffer())
+		t = b.text()
+	}
+
+	return t.normal()
+}
+
+func (e *Editor) redoChange(t text, n int) text {
+	for ; n > 0 && len(e.redo) > 0; n-- {
+		b := e.redo[len(e.redo)-1]
+		e.redo = e.redo[:len(e.redo)-1]
+		e.undo = append(e.undo, t.buffer())
+		t = b.text()
+	}
+
+	return t.normal()
+}
+
+// replace replaces the text between two offsets, keeping the cursor.
+func (t text) replace(start, end int, s string) text {
+	rs := make([]rune, 0, len(t.runes)-(end-start)+len(s))
+	rs = append(rs, t.runes[:start]...)
+	rs = append(rs, []rune(s)...)
+	rs = append(rs, t.runes[end:]...)
+
+	t.runes = rs
+	t.off = clamp(t.off, 0, len(rs))
+
+	return t
+}
@@ -0,0 +1,410 @@
+package vim_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/mikelorant/committed/internal/vim"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+// cursor marks the position of the cursor within a text.
+const cursor = "|"
+
+func TestUpdate(t *testing.T) {
+	t.Parallel()
+
+	type want struct {
+		text string
+		mode vim.Mode
+	}
+
+	tests := []struct {
+		name       string
+		text       string
+		keys       string
+		singleLine bool
+		want       want
+	}{
+		{
+			name: "insert",
+			text: "|",
+			keys: "hello",
+			want: want{text: "hello|", mode: vim.ModeInsert},
+		},
+		{
+			name: "escape",
+			text: "hello|",
+			keys: "<esc>",
+			want: want{text: "hell|o", mode: vim.ModeNormal},
+		},
+		{
+			name: "word_motions",
+			text: "one two-three four|",
+			keys: "<esc>0wwe",
+			want: want{text: "one two-thre|e four", mode: vim.ModeNormal},
+		},
+		{
+			name: "word_back",
+			text: "one two three|",
+			keys: "<esc>2b",
+			want: want{text: "one |two three", mode: vim.ModeNormal},
+		},
+		{
+			name: "line_motions",
+			text: "  indented line|",
+			keys: "<esc>0^",
+			want: want{text: "  |indented line", mode: vim.ModeNormal},
+		},
+		{
+			name: "end_of_line",
+			text: "|one\ntwo",
+			keys: "<esc>$",
+			want: want{text: "on|e\ntwo", mode: vim.ModeNormal},
+		},
+		{
+			name: "first_and_last_line",
+			text: "one\ntwo|\nthree",
+			keys: "<esc>Gkgg",
+			want: want{text: "|one\ntwo\nthree", mode: vim.ModeNormal},
+		},
+		{
+			name: "line_number",
+			text: "one\ntwo\nthree|",
+			keys: "<esc>2G",
+			want: want{text: "one\n|two\nthree", mode: vim.ModeNormal},
+		},
+		{
+			name: "delete_word",
+			text: "one two three|",
+			keys: "<esc>0dw",
+			want: want{text: "|two three", mode: vim.ModeNormal},
+		},
+		{
+			name: "delete_words_count",
+			text: "one two three four|",
+			keys: "<esc>0d2w",
+			want: want{text: "|three four", mode: vim.ModeNormal},
+		},
+		{
+			name: "delete_count_words",
+			text: "one two three four|",
+			keys: "<esc>03dw",
+			want: want{text: "|four", mode: vim.ModeNormal},
+		},
+		{
+			name: "delete_word_end_of_line",
+			text: "one two\nthree|",
+			keys: "<esc>kdw",
+			want: want{text: "one| \nthree", mode: vim.ModeNormal},
+		},
+		{
+			name: "delete_to_end",
+			text: "one two three|",
+			keys: "<esc>0wD",
+			want: want{text: "one| ", mode: vim.ModeNormal},
+		},
+		{
+			name: "delete_line",
+			text: "one\ntwo|\nthree",
+			keys: "<esc>dd",
+			want: want{text: "one\n|three", mode: vim.ModeNormal},
+		},
+		{
+			name: "delete_last_line",
+			text: "one\n  two\nthree|",
+			keys: "<esc>dd",
+			want: want{text: "one\n  |two", mode: vim.ModeNormal},
+		},
+		{
+			name: "delete_lines_count",
+			text: "one|\ntwo\nthree\nfour",
+			keys: "<esc>2dd",
+			want: want{text: "|three\nfour", mode: vim.ModeNormal},
+		},
+		{
+			name: "delete_line_down",
+			text: "one|\ntwo\nthree",
+			keys: "<esc>dj",
+			want: want{text: "|three", mode: vim.ModeNormal},
+		},
+		{
+			name: "delete_characters",
+			text: "abcdef|",
+			keys: "<esc>0x2x",
+			want: want{text: "|def", mode: vim.ModeNormal},
+		},
+		{
+			name: "change_word",
+			text: "one two three|",
+			keys: "<esc>0wcwfour<esc>",
+			want: want{text: "one fou|r three", mode: vim.ModeNormal},
+		},
+		{
+			name: "change_line",
+			text: "one\ntwo|\nthree",
+			keys: "<esc>ccnew<esc>",
+			want: want{text: "one\nne|w\nthree", mode: vim.ModeNormal},
+		},
+		{
+			name: "change_to_end",
+			text: "one two three|",
+			keys: "<esc>0wC2<esc>",
+			want: want{text: "one |2", mode: vim.ModeNormal},
+		},
+		{
+			name: "yank_put_word",
+			text: "one two|",
+			keys: "<esc>0yw$p",
+			want: want{text: "one twoone| ", mode: vim.ModeNormal},
+		},
+		{
+			name: "yank_put_line",
+			text: "one|\ntwo",
+			keys: "<esc>yyjp",
+			want: want{text: "one\ntwo\n|one", mode: vim.ModeNormal},
+		},
+		{
+			name: "yank_put_line_before",
+			text: "one\ntwo|",
+			keys: "<esc>yykP",
+			want: want{text: "|two\none\ntwo", mode: vim.ModeNormal},
+		},
+		{
+			name: "delete_put",
+			text: "one two|",
+			keys: "<esc>0dwP",
+			want: want{text: "one| two", mode: vim.ModeNormal},
+		},
+		{
+			name: "undo",
+			text: "one two three|",
+			keys: "<esc>0dwdwu",
+			want: want{text: "|two three", mode: vim.ModeNormal},
+		},
+		{
+			name: "undo_count",
+			text: "one two three|",
+			keys: "<esc>0dwdw2u",
+			want: want{text: "|one two three", mode: vim.ModeNormal},
+		},
+		{
+			name: "redo",
+			text: "one two three|",
+			keys: "<esc>0dwu<ctrl+r>",
+			want: want{text: "|two three", mode: vim.ModeNormal},
+		},
+		{
+			name: "undo_insert",
+			text: "one|",
+			keys: "<esc>A two<esc>u",
+			want: want{text: "on|e", mode: vim.ModeNormal},
+		},
+		{
+			name: "repeat_delete",
+			text: "one two three four|",
+			keys: "<esc>0dw..",
+			want: want{text: "|four", mode: vim.ModeNormal},
+		},
+		{
+			name: "repeat_change",
+			text: "one two three|",
+			keys: "<esc>0cwsix<esc>w.",
+			want: want{text: "six si|x three", mode: vim.ModeNormal},
+		},
+		{
+			name: "repeat_append",
+			text: "one|\ntwo",
+			keys: "<esc>A;<esc>j.",
+			want: want{text: "one;\ntwo|;", mode: vim.ModeNormal},
+		},
+		{
+			name: "repeat_count",
+			text: "abcdef|",
+			keys: "<esc>0x3.",
+			want: want{text: "|ef", mode: vim.ModeNormal},
+		},
+		{
+			name: "open_line",
+			text: "one|\nthree",
+			keys: "<esc>otwo<esc>",
+			want: want{text: "one\ntw|o\nthree", mode: vim.ModeNormal},
+		},
+		{
+			name: "open_line_above",
+			text: "two|",
+			keys: "<esc>Oone<esc>",
+			want: want{text: "on|e\ntwo", mode: vim.ModeNormal},
+		},
+		{
+			name: "insert_start",
+			text: "  two|",
+			keys: "<esc>Ione <esc>",
+			want: want{text: "  one| two", mode: vim.ModeNormal},
+		},
+		{
+			name: "visual_delete",
+			text: "one two three|",
+			keys: "<esc>0wvlld",
+			want: want{text: "one | three", mode: vim.ModeNormal},
+		},
+		{
+			name: "visual_yank",
+			text: "one two three|",
+			keys: "<esc>0vey$p",
+			want: want{text: "one two threeon|e", mode: vim.ModeNormal},
+		},
+		{
+			name: "visual_change",
+			text: "one two three|",
+			keys: "<esc>0wvecsix",
+			want: want{text: "one six| three", mode: vim.ModeInsert},
+		},
+		{
+			name: "visual_line_delete",
+			text: "one\ntwo\nthree|",
+			keys: "<esc>kVkd",
+			want: want{text: "|three", mode: vim.ModeNormal},
+		},
+		{
+			name: "visual_mode",
+			text: "one|",
+			keys: "<esc>v",
+			want: want{text: "on|e", mode: vim.ModeVisual},
+		},
+		{
+			name: "visual_exit",
+			text: "one|",
+			keys: "<esc>vh<esc>",
+			want: want{text: "o|ne", mode: vim.ModeNormal},
+		},
+		{
+			name:       "single_line_open",
+			text:       "one|",
+			keys:       "<esc>0o two<esc>",
+			singleLine: true,
+			want:       want{text: "one tw|o", mode: vim.ModeNormal},
+		},
+		{
+			name:       "single_line_put_line",
+			text:       "one|",
+			keys:       "<esc>yyp",
+			singleLine: true,
+			want:       want{text: "oneon|e", mode: vim.ModeNormal},
+		},
+		{
+			name:       "single_line_put_empty_line",
+			text:       "|",
+			keys:       "<esc>yyp",
+			singleLine: true,
+			want:       want{text: "|", mode: vim.ModeNormal},
+		},
+		{
+			name:       "single_line_put_empty_line_before",
+			text:       "|",
+			keys:       "<esc>ddP",
+			singleLine: true,
+			want:       want{text: "|", mode: vim.ModeNormal},
+		},
+		{
+			name: "invalid",
+			text: "one|",
+			keys: "<esc>dqzgq",
+			want: want{text: "on|e", mode: vim.ModeNormal},
+		},
+		{
+			name: "paragraph",
+			text: heredoc.Doc(`
+				First line of the body.
+
+				Second| paragraph.`),
+			keys: "<esc>gg3wD",
+			want: want{
+				text: "First line of| \n\nSecond paragraph.",
+				mode: vim.ModeNormal,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			e := vim.New(tt.singleLine)
+			b := buffer(tt.text)
+
+			for _, key := range keys(tt.keys) {
+				var ok bool
+
+				b, ok = e.Update(key, b)
+				if !ok {
+					b = typeKey(b, key)
+				}
+			}
+
+			assert.Equal(t, tt.want.text, marked(b))
+			assert.Equal(t, tt.want.mode, e.Mode())
+		})
+	}
+}
+
+func TestModeString(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "INSERT", vim.ModeInsert.String())
+	assert.Equal(t, "NORMAL", vim.ModeNormal.String())
+	assert.Equal(t, "VISUAL", vim.ModeVisual.String())
+	assert.Equal(t, "VISUAL LINE", vim.ModeVisualLine.String())
+}
+
+// buffer returns a buffer with the cursor at the marker.
+func buffer(s string) vim.Buffer {
+	before, after, _ := strings.Cut(s, cursor)
+	lines := strings.Split(before, "\n")
+
+	return vim.NewBuffer(before+after, len(lines)-1, len([]rune(lines[len(lines)-1])))
+}
+
+// marked returns the text of a buffer with the cursor marked.
+func marked(b vim.Buffer) string {
+	lines := append([]string(nil), b.Lines...)
+	rs := []rune(lines[b.Row])
+	lines[b.Row] = string(rs[:b.Col]) + cursor + string(rs[b.Col:])
+
+	return strings.Join(lines, "\n")
+}
+
+// keys splits keys where special keys are named within angle brackets.
+func keys(s string) []string {
+	var ks []string
+
+	for s != "" {
+		if strings.HasPrefix(s, "<") {
+			if k, rest, ok := strings.Cut(s[1:], ">"); ok {
+				ks = append(ks, k)
+				s = rest
+
+				continue
+			}
+		}
+
+		r := []rune(s)[0]
+		ks = append(ks, string(r))
+		s = s[len(string(r)):]
+	}
+
+	return ks
+}
+
+// typeKey inserts a typed key as a text input would.
+func typeKey(b vim.Buffer, key string) vim.Buffer {
+	rs := []rune(b.Lines[b.Row])
+	b.Lines[b.Row] = string(rs[:b.Col]) + key + string(rs[b.Col:])
+	b.Col += len([]rune(key))
+
+	return b
+}
@@ -0,0 +1,251 @@
+// Package wrap formats the body of a commit message to a width. Only prose
+// is wrapped, with list items keeping their hanging indent. Code, tables,
+// comments and trailers are left as written and words such as URLs are never
+// broken.
+package wrap
+
+import (
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/mikelorant/committed/internal/commit"
+)
+
+type kind int
+
+const (
+	kindText kind = iota
+	kindBlank
+	kindItem
+	kindFence
+	kindCode
+	kindTable
+	kindComment
+	kindTrailer
+)
+
+// line is a line of the text with the prefix kept on its first wrapped line
+// and the indent of the lines following.
+type line struct {
+	text   string
+	kind   kind
+	prefix string
+	hang   string
+}
+
+// word is a word with the space before it.
+type word struct {
+	space string
+	text  string
+}
+
+var (
+	itemPrefix  = regexp.MustCompile(`^\s*([-*+]|[0-9]+[.)])\s+`)
+	fencePrefix = regexp.MustCompile("^\\s*(```|~~~)")
+	words       = regexp.MustCompile(`(\s*)(\S+)`)
+)
+
+const codeIndent = 4
+
+// Format wraps each line of prose longer than the width.
+func Format(str string, width int) string {
+	var out []string
+
+	for _, l := range classify(strings.Split(str, "\n")) {
+		if !wrappable(l.kind) || length(l.text) <= width {
+			out = append(out, l.text)
+			continue
+		}
+
+		out = append(out, fill(l.prefix, l.hang, split(l.text[len(l.prefix):]), width)...)
+	}
+
+	return strings.Join(out, "\n")
+}
+
+// Reflow joins and wraps the paragraph or list item of a line. The row of the
+// last line of the paragraph is returned so the cursor can follow it.
+func Reflow(str string, width, row int) (string, int) {
+	ls := classify(strings.Split(str, "\n"))
+	if row < 0 || row >= len(ls) || !wrappable(ls[row].kind) {
+		return str, row
+	}
+
+	start := row
+	for start > 0 && ls[start].kind == kindText && wrappable(ls[start-1].kind) {
+		start--
+	}
+
+	end := start + 1
+	for end < len(ls) && ls[end].kind == kindText {
+		end++
+	}
+
+	var ws []word
+
+	for i, l := range ls[start:end] {
+		lws := split(l.text[len(l.prefix):])
+		if i > 0 && len(lws) > 0 {
+			lws[0].space = " "
+		}
+
+		ws = append(ws, lws...)
+	}
+
+	first := ls[start]
+
+	filled := fill(first.prefix, first.hang, ws, width)
+
+	out := make([]string, 0, len(ls))
+	for _, l := range ls[:start] {
+		out = append(out, l.text)
+	}
+
+	out = append(out, filled...)
+
+	for _, l := range ls[end:] {
+		out = append(out, l.text)
+	}
+
+	return strings.Join(out, "\n"), start + len(filled) - 1
+}
+
+// classify sets the kind of each line. List items and the lines following
+// them take the hanging indent, while indented lines that do not belong to
+// a list are code.
+func classify(lines []string) []line {
+	ls := make([]line, len(lines))
+	trailers := trailerStart(lines)
+
+	var fence string
+
+	list := false
+
+	for i, text := range lines {
+		l := line{text: text}
+		prev := kindBlank
+
+		if i > 0 {
+			prev = ls[i-1].kind
+		}
+
+		switch {
+		case fence != "":
+			l.kind = kindFence
+
+			if m := fencePrefix.FindStringSubmatch(text); m != nil && m[1] == fence {
+				fence = ""
+			}
+		case fencePrefix.MatchString(text):
+			l.kind = kindFence
+			fence = fencePrefix.FindStringSubmatch(text)[1]
+		case strings.TrimSpace(text) == "":
+			l.kind = kindBlank
+		case i >= trailers:
+			l.kind = kindTrailer
+		case strings.HasPrefix(text, "#"):
+			l.kind = kindComment
+		case strings.HasPrefix(strings.TrimSpace(text), "|"):
+			l.kind = kindTable
+		case itemPrefix.MatchString(text):
+			l.kind = kindItem
+			l.prefix = itemPrefix.FindString(text)
+			l.hang = strings.Repeat(" ", length(l.prefix))
+			list = true
+		case !list && isCode(text) && (prev == kindBlank || prev == kindCode):
+			l.kind = kindCode
+		default:
+			l.kind = kindText
+			l.prefix = leading(text)
+			l.hang = l.prefix
+
+			if prev == kindItem || (prev == kindText && list) {
+				l.hang = ls[i-1].hang
+			}
+
+			if l.prefix == "" {
+				list = false
+			}
+		}
+
+		ls[i] = l
+	}
+
+	return ls
+}
+
+// trailerStart returns the first line of the last paragraph when every line
+// of it is a trailer.
+func trailerStart(lines []string) int {
+	end := len(lines)
+	for end > 0 && strings.TrimSpace(lines[end-1]) == "" {
+		end--
+	}
+
+	start := end
+	for start > 0 && strings.TrimSpace(lines[start-1]) != "" {
+		start--
+	}
+
+	if start == end {
+		return len(lines)
+	}
+
+	for _, l := range lines[start:end] {
+		if _, ok := commit.ParseTrailer(l); !ok {
+			return len(lines)
+		}
+	}
+
+	return start
+}
+
+// fill places words on lines up to the width. A word longer than the width
+// is given a line of its own rather than being broken.
+func fill(prefix, hang string, ws []word, width int) []string {
+	var out []string
+
+	cur, empty := prefix, true
+
+	for _, w := range ws {
+		switch {
+		case empty:
+			cur += w.text
+			empty = false
+		case length(cur)+length(w.space)+length(w.text) <= width:
+			cur += w.space + w.text
+		default:
+			out = append(out, cur)
+			cur = hang + w.text
+		}
+	}
+
+	return append(out, cur)
+}
+
+func split(str string) []word {
+	var ws []word
+
+	for _, m := range words.FindAllStringSubmatch(str, -1) {
+		ws = append(ws, word{space: m[1], text: m[2]})
+	}
+
+	return ws
+}
+
+func wrappable(k kind) bool {
+	return k == kindText || k == kindItem
+}
+
+func isCode(str string) bool {
+	return strings.HasPrefix(str, "\t") || strings.HasPrefix(str, strings.Repeat(" ", codeIndent))
+}
+
+func leading(str string) string {
+	return str[:len(str)-len(strings.TrimLeft(str, " \t"))]
+}
+
+func length(str string) int {
+	return utf8.RuneCountInString(str)
+}
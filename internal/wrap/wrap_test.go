@@ -0,0 +1,288 @@
+package wrap_test
+
+import (
+	"testing"
+
+	"github.com/mikelorant/committed/internal/wrap"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormat(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		text  string
+		width int
+		want  string
+	}{
+		{
+			name:  "empty",
+			width: 20,
+		},
+		{
+			name:  "short",
+			text:  "Short line.",
+			width: 20,
+			want:  "Short line.",
+		},
+		{
+			name:  "paragraph",
+			text:  "The quick brown fox jumps over the lazy dog.",
+			width: 20,
+			want: heredoc.Doc(`
+				The quick brown fox
+				jumps over the lazy
+				dog.`),
+		},
+		{
+			name:  "spacing",
+			text:  "First sentence.  Second sentence here.",
+			width: 20,
+			want: heredoc.Doc(`
+				First sentence.
+				Second sentence
+				here.`),
+		},
+		{
+			name: "lines_kept",
+			text: heredoc.Doc(`
+				First line.
+
+				Second line.`),
+			width: 20,
+			want: heredoc.Doc(`
+				First line.
+
+				Second line.`),
+		},
+		{
+			name: "list",
+			text: heredoc.Doc(`
+				- Typically a hyphen or asterisk is used for the bullet.
+				* Use a hanging indent.
+				12. Numbered items are indented to their text.`),
+			width: 24,
+			want: heredoc.Doc(`
+				- Typically a hyphen or
+				  asterisk is used for
+				  the bullet.
+				* Use a hanging indent.
+				12. Numbered items are
+				    indented to their
+				    text.`),
+		},
+		{
+			name: "list_nested",
+			text: heredoc.Doc(`
+				- Outer item.
+				  - Nested item with more words than fit.`),
+			width: 20,
+			want: heredoc.Doc(`
+				- Outer item.
+				  - Nested item with
+				    more words than
+				    fit.`),
+		},
+		{
+			name: "list_continuation",
+			text: heredoc.Doc(`
+				- First line of an item
+				  with a continuation line that is long.`),
+			width: 24,
+			want: heredoc.Doc(`
+				- First line of an item
+				  with a continuation
+				  line that is long.`),
+		},
+		{
+			name:  "url",
+			text:  "See https://example.com/a-very-long-path/with-hyphens for details.",
+			width: 20,
+			want: heredoc.Doc(`
+				See
+				https://example.com/a-very-long-path/with-hyphens
+				for details.`),
+		},
+		{
+			name: "fenced_code",
+			text: heredoc.Doc(`
+				Example:
+
+				` + "```" + `
+				go test ./... -run TestFormat -count 1 -v
+				` + "```"),
+			width: 20,
+			want: heredoc.Doc(`
+				Example:
+
+				` + "```" + `
+				go test ./... -run TestFormat -count 1 -v
+				` + "```"),
+		},
+		{
+			name: "indented_code",
+			text: heredoc.Doc(`
+				Example:
+
+				    go test ./... -run TestFormat -count 1 -v`),
+			width: 20,
+			want: heredoc.Doc(`
+				Example:
+
+				    go test ./... -run TestFormat -count 1 -v`),
+		},
+		{
+			name:  "table",
+			text:  "| Column one | Column two | Column three |",
+			width: 20,
+			want:  "| Column one | Column two | Column three |",
+		},
+		{
+			name: "trailers",
+			text: heredoc.Doc(`
+				Body.
+
+				Co-authored-by: John Doe <john.doe@example.com>
+				Reviewed-by: Jane Doe <jane.doe@example.com>`),
+			width: 20,
+			want: heredoc.Doc(`
+				Body.
+
+				Co-authored-by: John Doe <john.doe@example.com>
+				Reviewed-by: Jane Doe <jane.doe@example.com>`),
+		},
+		{
+			name: "trailer_like_prose",
+			text: heredoc.Doc(`
+				Note: this sentence is too long to fit.
+				It is not a trailer.`),
+			width: 20,
+			want: heredoc.Doc(`
+				Note: this sentence
+				is too long to fit.
+				It is not a trailer.`),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, wrap.Format(tt.text, tt.width))
+		})
+	}
+}
+
+func TestReflow(t *testing.T) {
+	t.Parallel()
+
+	type want struct {
+		text string
+		row  int
+	}
+
+	tests := []struct {
+		name  string
+		text  string
+		width int
+		row   int
+		want  want
+	}{
+		{
+			name: "paragraph",
+			text: heredoc.Doc(`
+				The quick
+				brown fox jumps
+				over the lazy dog.
+
+				Next paragraph.`),
+			width: 20,
+			row:   1,
+			want: want{
+				text: heredoc.Doc(`
+					The quick brown fox
+					jumps over the lazy
+					dog.
+
+					Next paragraph.`),
+				row: 2,
+			},
+		},
+		{
+			name: "list_item",
+			text: heredoc.Doc(`
+				- First item
+				  continued.
+				- Second item that
+				  is long enough to wrap.`),
+			width: 20,
+			row:   3,
+			want: want{
+				text: heredoc.Doc(`
+					- First item
+					  continued.
+					- Second item that
+					  is long enough to
+					  wrap.`),
+				row: 4,
+			},
+		},
+		{
+			name: "list_item_joined",
+			text: heredoc.Doc(`
+				- First
+				  item.
+				- Second item.`),
+			width: 20,
+			row:   0,
+			want: want{
+				text: heredoc.Doc(`
+					- First item.
+					- Second item.`),
+				row: 0,
+			},
+		},
+		{
+			name: "code",
+			text: heredoc.Doc(`
+				Text.
+
+				    code
+				    more code`),
+			width: 20,
+			row:   2,
+			want: want{
+				text: heredoc.Doc(`
+					Text.
+
+					    code
+					    more code`),
+				row: 2,
+			},
+		},
+		{
+			name:  "blank",
+			text:  "Text.\n\nMore text.",
+			width: 20,
+			row:   1,
+			want:  want{text: "Text.\n\nMore text.", row: 1},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			text, row := wrap.Reflow(tt.text, tt.width, tt.row)
+			assert.Equal(t, tt.want.text, text)
+			assert.Equal(t, tt.want.row, row)
+		})
+	}
+}